@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 )
 
 // VisibilityValue represents a visibility level within GitLab.
@@ -69,6 +71,11 @@ type GroupParameters struct {
 	// +immutable
 	Path string `json:"path"`
 
+	// AdoptExisting makes the provider adopt a group that already exists at
+	// the parent and path of this resource instead of failing to create it.
+	// +optional
+	AdoptExisting *bool `json:"adoptExisting,omitempty"`
+
 	// The group’s description.
 	// +optional
 	Description *string `json:"description,omitempty"`
@@ -128,7 +135,12 @@ type GroupParameters struct {
 	// +optional
 	RequestAccessEnabled *bool `json:"requestAccessEnabled,omitempty"`
 
-	// The parent group ID for creating nested group.
+	// The parent group ID for creating nested group. Changing it on an
+	// existing group transfers the group to the new parent, or to the top
+	// level if set to 0, provided the group carries the
+	// gitlab.crossplane.io/allow-transfer: "true" annotation. Once parentId
+	// is set, changing parentIdRef or parentIdSelector only resolves it
+	// again when the reference or selector policy is set to resolve: Always.
 	// +optional
 	ParentID *int `json:"parentId,omitempty"`
 
@@ -150,9 +162,26 @@ type GroupParameters struct {
 	// +optional
 	ExtraSharedRunnersMinutesLimit *int `json:"extraSharedRunnersMinutesLimit,omitempty"`
 
+	// Avatar sets the group avatar from an image stored in a ConfigMap or
+	// Secret. It is uploaded again whenever the image changes.
+	// +optional
+	Avatar *common.Avatar `json:"avatar,omitempty"`
+
+	// PermanentlyRemove immediately removes the group when the managed
+	// resource is deleted on instances with delayed group deletion, instead of
+	// leaving it marked for deletion. Defaults to false.
+	// +optional
+	PermanentlyRemove *bool `json:"permanentlyRemove,omitempty"`
+
 	// SharedWithGroups create links for sharing a group with another group.
+	// When unset, the shares of the group are not managed: existing shares
+	// are left alone, which allows them to be managed with GroupShare
+	// resources instead. Set it to an empty list to remove all shares that
+	// are not listed. Note that earlier releases removed every share of a
+	// group that left this field unset; such groups now need an empty list
+	// to keep that behaviour.
 	// +optional
-	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups,omitempty"`
+	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups"`
 }
 
 // AccessLevelValue represents a permission level within GitLab.
@@ -234,6 +263,7 @@ type GroupObservation struct {
 	WebURL              *string                       `json:"webUrl,omitempty"`
 	FullName            *string                       `json:"fullName,omitempty"`
 	FullPath            *string                       `json:"fullPath,omitempty"`
+	ParentID            *int                          `json:"parentId,omitempty"`
 	Statistics          *StorageStatistics            `json:"statistics,omitempty"`
 	CustomAttributes    []CustomAttribute             `json:"customAttributes,omitempty"`
 	LDAPCN              *string                       `json:"ldapCn,omitempty"`
@@ -265,6 +295,10 @@ type GroupStatus struct {
 	AtProvider          GroupObservation `json:"atProvider,omitempty"`
 }
 
+// AnnotationKeyAllowTransfer must be set to "true" on a Group before the
+// provider transfers it to a different parent group.
+const AnnotationKeyAllowTransfer = "gitlab.crossplane.io/allow-transfer"
+
 // +kubebuilder:object:root=true
 
 // A Group is a managed resource that represents a Gitlab Group
@@ -54,6 +54,14 @@ type MemberParameters struct {
 	// +optional
 	UserID *int `json:"userID,omitempty"`
 
+	// UserIDRef is a reference to a user to retrieve its userId
+	// +optional
+	UserIDRef *xpv1.Reference `json:"userIdRef,omitempty"`
+
+	// UserIDSelector selects reference to a user to retrieve its userId.
+	// +optional
+	UserIDSelector *xpv1.Selector `json:"userIdSelector,omitempty"`
+
 	// The userName of the member.
 	// +optional
 	UserName *string `json:"userName,omitempty"`
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A GroupShareParameters defines the desired state of a Gitlab Group Share.
+type GroupShareParameters struct {
+	// The ID of the group that is shared.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// The ID of the group to share with.
+	// +optional
+	// +immutable
+	SharedWithGroupID *int `json:"sharedWithGroupId,omitempty"`
+
+	// SharedWithGroupIDRef is a reference to a group to retrieve its sharedWithGroupId
+	// +optional
+	// +immutable
+	SharedWithGroupIDRef *xpv1.Reference `json:"sharedWithGroupIdRef,omitempty"`
+
+	// SharedWithGroupIDSelector selects reference to a group to retrieve its sharedWithGroupId.
+	// +optional
+	SharedWithGroupIDSelector *xpv1.Selector `json:"sharedWithGroupIdSelector,omitempty"`
+
+	// The role (access_level) to grant the shared-with group.
+	// Changing it re-shares the group.
+	// https://docs.gitlab.com/ee/api/members.html#roles
+	GroupAccessLevel AccessLevelValue `json:"groupAccessLevel"`
+
+	// Share expiration date in ISO 8601 format: 2016-09-26.
+	// Changing it re-shares the group.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// GroupShareObservation represents the observed state of a Gitlab Group Share.
+type GroupShareObservation struct {
+	GroupName        string       `json:"groupName,omitempty"`
+	GroupFullPath    string       `json:"groupFullPath,omitempty"`
+	GroupAccessLevel int          `json:"groupAccessLevel,omitempty"`
+	ExpiresAt        *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// A GroupShareSpec defines the desired state of a Gitlab Group Share.
+type GroupShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupShareParameters `json:"forProvider"`
+}
+
+// A GroupShareStatus represents the observed state of a Gitlab Group Share.
+type GroupShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupShare is a managed resource that represents a Gitlab group shared
+// with another group. Leave spec.forProvider.sharedWithGroups of the shared
+// Group unset when using GroupShares, otherwise the Group removes every share
+// it does not list.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Group ID",type="integer",JSONPath=".spec.forProvider.groupId"
+// +kubebuilder:printcolumn:name="Shared With",type="string",JSONPath=".status.atProvider.groupFullPath"
+// +kubebuilder:printcolumn:name="Access Level",type="integer",JSONPath=".spec.forProvider.groupAccessLevel"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type GroupShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupShareSpec   `json:"spec"`
+	Status GroupShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupShareList contains a list of GroupShare items
+type GroupShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupShare `json:"items"`
+}
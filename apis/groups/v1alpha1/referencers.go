@@ -21,8 +21,11 @@ import (
 	"strconv"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	usersv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
 )
 
 // resolve int ptr to string value
@@ -47,6 +50,18 @@ func toPtrValue(v string) *int {
 	return &r
 }
 
+// GroupID extracts the numeric ID of a Group from its status, which works
+// regardless of whether its external name is an ID or a full path.
+func GroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		g, ok := mg.(*Group)
+		if !ok || g.Status.AtProvider.ID == nil {
+			return ""
+		}
+		return strconv.Itoa(*g.Status.AtProvider.ID)
+	}
+}
+
 // ResolveReferences of this Variable
 func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -57,7 +72,7 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -80,7 +95,7 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -90,6 +105,22 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.GroupID = toPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
 
+	// resolve spec.forProvider.userIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.UserID),
+		Reference:    mg.Spec.ForProvider.UserIDRef,
+		Selector:     mg.Spec.ForProvider.UserIDSelector,
+		To:           reference.To{Managed: &usersv1alpha1.User{}, List: &usersv1alpha1.UserList{}},
+		Extract:      usersv1alpha1.UserID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userId")
+	}
+
+	mg.Spec.ForProvider.UserID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.UserIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -103,7 +134,7 @@ func (mg *DeployToken) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.GroupIDRef,
 		Selector:     mg.Spec.ForProvider.GroupIDSelector,
 		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 	})
 
 	if err != nil {
@@ -116,6 +147,45 @@ func (mg *DeployToken) ResolveReferences(ctx context.Context, c client.Reader) e
 	return nil
 }
 
+// ResolveReferences of this GroupShare
+func (mg *GroupShare) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.GroupID),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.sharedWithGroupIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.SharedWithGroupID),
+		Reference:    mg.Spec.ForProvider.SharedWithGroupIDRef,
+		Selector:     mg.Spec.ForProvider.SharedWithGroupIDSelector,
+		To:           reference.To{Managed: &Group{}, List: &GroupList{}},
+		Extract:      GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sharedWithGroupId")
+	}
+
+	mg.Spec.ForProvider.SharedWithGroupID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SharedWithGroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this Group.
 func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -131,7 +201,7 @@ func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
 
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(idstrp),
-		Extract:      reference.ExternalName(),
+		Extract:      GroupID(),
 		Reference:    mg.Spec.ForProvider.ParentIDRef,
 		Selector:     mg.Spec.ForProvider.ParentIDSelector,
 		To: reference.To{
@@ -155,7 +225,7 @@ func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
 		idstr := strconv.Itoa(*mg.Spec.ForProvider.SharedWithGroups[i3].GroupID)
 		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 			CurrentValue: reference.FromPtrValue(&idstr),
-			Extract:      reference.ExternalName(),
+			Extract:      GroupID(),
 			Reference:    mg.Spec.ForProvider.SharedWithGroups[i3].GroupIDRef,
 			Selector:     mg.Spec.ForProvider.SharedWithGroups[i3].GroupIDSelector,
 			To: reference.To{
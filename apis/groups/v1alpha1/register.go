@@ -69,9 +69,18 @@ var (
 	VariableGroupVersionKind = SchemeGroupVersion.WithKind(VariableKind)
 )
 
+// GroupShare type metadata
+var (
+	GroupShareKind             = reflect.TypeOf(GroupShare{}).Name()
+	GroupShareGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: GroupShareKind}.String()
+	GroupShareKindAPIVersion   = GroupShareKind + "." + SchemeGroupVersion.String()
+	GroupShareGroupVersionKind = SchemeGroupVersion.WithKind(GroupShareKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Group{}, &GroupList{})
 	SchemeBuilder.Register(&Member{}, &MemberList{})
 	SchemeBuilder.Register(&DeployToken{}, &DeployTokenList{})
 	SchemeBuilder.Register(&Variable{}, &VariableList{})
+	SchemeBuilder.Register(&GroupShare{}, &GroupShareList{})
 }
@@ -29,6 +29,7 @@ type AccessTokenParameters struct {
 	// +optional
 	// +immutable
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
 	ProjectID *string `json:"projectId,omitempty"`
 
 	// ProjectIDRef is a reference to a project to retrieve its projectId
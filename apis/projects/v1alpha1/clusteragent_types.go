@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClusterAgentParameters define the desired state of a GitLab agent for
+// Kubernetes registered with a project.
+// https://docs.gitlab.com/ee/api/cluster_agents.html
+type ClusterAgentParameters struct {
+	// ProjectID is the ID of the project to register the agent with.
+	// +optional
+	// +immutable
+	ProjectID *int `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the agent. The agent reads its configuration from
+	// .gitlab/agents/<name>/config.yaml in the project.
+	// +immutable
+	Name string `json:"name"`
+}
+
+// ClusterAgentObservation represents the observed state of a GitLab agent
+// for Kubernetes.
+type ClusterAgentObservation struct {
+	CreatedAt                      *metav1.Time `json:"createdAt,omitempty"`
+	CreatedByUserID                int          `json:"createdByUserID,omitempty"`
+	ConfigProjectPathWithNamespace string       `json:"configProjectPathWithNamespace,omitempty"`
+}
+
+// A ClusterAgentSpec defines the desired state of a GitLab agent for
+// Kubernetes.
+type ClusterAgentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterAgentParameters `json:"forProvider"`
+}
+
+// A ClusterAgentStatus represents the observed state of a GitLab agent for
+// Kubernetes.
+type ClusterAgentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterAgentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterAgent is a managed resource that represents a GitLab agent for
+// Kubernetes registered with a project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGENT",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ClusterAgent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAgentSpec   `json:"spec"`
+	Status ClusterAgentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAgentList contains a list of ClusterAgent items
+type ClusterAgentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAgent `json:"items"`
+}
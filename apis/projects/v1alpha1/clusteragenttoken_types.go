@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClusterAgentTokenParameters define the desired state of a token of a GitLab
+// agent for Kubernetes.
+// https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token
+type ClusterAgentTokenParameters struct {
+	// ProjectID is the ID of the project the agent is registered with.
+	// +optional
+	// +immutable
+	ProjectID *int `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// AgentID is the ID of the agent to create the token for.
+	// +optional
+	// +immutable
+	AgentID *int `json:"agentId,omitempty"`
+
+	// AgentIDRef is a reference to a cluster agent to retrieve its agentId
+	// +optional
+	// +immutable
+	AgentIDRef *xpv1.Reference `json:"agentIdRef,omitempty"`
+
+	// AgentIDSelector selects reference to a cluster agent to retrieve its
+	// agentId.
+	// +optional
+	AgentIDSelector *xpv1.Selector `json:"agentIdSelector,omitempty"`
+
+	// Name of the token.
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the token.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+}
+
+// ClusterAgentTokenObservation represents the observed state of a token of a
+// GitLab agent for Kubernetes.
+type ClusterAgentTokenObservation struct {
+	Status          string       `json:"status,omitempty"`
+	CreatedAt       *metav1.Time `json:"createdAt,omitempty"`
+	CreatedByUserID int          `json:"createdByUserID,omitempty"`
+	LastUsedAt      *metav1.Time `json:"lastUsedAt,omitempty"`
+}
+
+// A ClusterAgentTokenSpec defines the desired state of a token of a GitLab
+// agent for Kubernetes.
+type ClusterAgentTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterAgentTokenParameters `json:"forProvider"`
+}
+
+// A ClusterAgentTokenStatus represents the observed state of a token of a
+// GitLab agent for Kubernetes.
+type ClusterAgentTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterAgentTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterAgentToken is a managed resource that represents a token of a
+// GitLab agent for Kubernetes. The token is published as the token connection
+// detail, which is the key the gitlab-agent Helm chart reads from
+// config.secretName.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ClusterAgentToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAgentTokenSpec   `json:"spec"`
+	Status ClusterAgentTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAgentTokenList contains a list of ClusterAgentToken items
+type ClusterAgentTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAgentToken `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypeLastPipelineSucceeded is the type of the condition a PipelineSchedule
+// uses to report the outcome of the last pipeline it ran. It is True when
+// that pipeline succeeded, False when it failed or was canceled and Unknown
+// while it is still pending or running. Schedules that never ran a pipeline
+// don't report it.
+const TypeLastPipelineSucceeded xpv1.ConditionType = "LastPipelineSucceeded"
+
+// Reasons for the LastPipelineSucceeded condition.
+const (
+	// ReasonPipelineSucceeded means the last pipeline succeeded.
+	ReasonPipelineSucceeded xpv1.ConditionReason = "Succeeded"
+
+	// ReasonPipelineFailed means the last pipeline failed or was canceled.
+	ReasonPipelineFailed xpv1.ConditionReason = "Failed"
+
+	// ReasonPipelineRunning means the last pipeline has not finished yet.
+	ReasonPipelineRunning xpv1.ConditionReason = "Running"
+)
+
+// LastPipelineSucceeded returns a condition that indicates the last pipeline
+// of a pipeline schedule succeeded.
+func LastPipelineSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLastPipelineSucceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPipelineSucceeded,
+	}
+}
+
+// LastPipelineFailed returns a condition that indicates the last pipeline of
+// a pipeline schedule failed or was canceled.
+func LastPipelineFailed(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLastPipelineSucceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPipelineFailed,
+		Message:            msg,
+	}
+}
+
+// LastPipelineRunning returns a condition that indicates the last pipeline of
+// a pipeline schedule has not finished yet.
+func LastPipelineRunning(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLastPipelineSucceeded,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPipelineRunning,
+		Message:            msg,
+	}
+}
@@ -29,6 +29,7 @@ type DeployKeyParameters struct {
 	// +optional
 	// +immutable
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
 	// +crossplane:generate:reference:refFieldName=ProjectIDRef
 	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
 	ProjectID *string `json:"projectId,omitempty"`
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PipelineParameters define the pipeline run on creation of a Pipeline. The
+// pipeline is run once, changing the parameters afterwards does not run it
+// again.
+// https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
+type PipelineParameters struct {
+	// ProjectID is the ID of the project to run the pipeline in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Ref is the branch or tag to run the pipeline on. At least 1 of [Ref,
+	// RefRef, RefSelector] required.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Branch
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.BranchName()
+	Ref string `json:"ref,omitempty"`
+
+	// RefRef is a reference to a Branch to retrieve its name as Ref.
+	// +optional
+	RefRef *xpv1.Reference `json:"refRef,omitempty"`
+
+	// RefSelector selects reference to a Branch to retrieve its name as Ref.
+	// +optional
+	RefSelector *xpv1.Selector `json:"refSelector,omitempty"`
+
+	// Variables are passed to the pipeline.
+	// +optional
+	// +immutable
+	Variables []PipelineVariable `json:"variables,omitempty"`
+}
+
+// PipelineObservation represents the observed state of a Gitlab pipeline.
+type PipelineObservation struct {
+	ID         int           `json:"id,omitempty"`
+	Status     string        `json:"status,omitempty"`
+	SHA        string        `json:"sha,omitempty"`
+	WebURL     string        `json:"webUrl,omitempty"`
+	StartedAt  *metav1.Time  `json:"startedAt,omitempty"`
+	FinishedAt *metav1.Time  `json:"finishedAt,omitempty"`
+	Jobs       []PipelineJob `json:"jobs,omitempty"`
+}
+
+// PipelineJob represents the observed state of a job of a Gitlab pipeline.
+type PipelineJob struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Stage  string `json:"stage,omitempty"`
+	Status string `json:"status"`
+}
+
+// A PipelineSpec defines the desired state of a Gitlab pipeline.
+type PipelineSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PipelineParameters `json:"forProvider"`
+}
+
+// A PipelineStatus represents the observed state of a Gitlab pipeline.
+type PipelineStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PipelineObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Pipeline is a managed resource that runs a Gitlab pipeline once and
+// tracks its status. It becomes ready when the pipeline succeeds.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Pipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipelineSpec   `json:"spec"`
+	Status PipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineList contains a list of Pipeline items
+type PipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Pipeline `json:"items"`
+}
@@ -31,6 +31,7 @@ type PipelineScheduleParameters struct {
 	// +optional
 	// +immutable
 	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
 	// +crossplane:generate:reference:refFieldName=ProjectIDRef
 	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
 	ProjectID *string `json:"projectId,omitempty"`
@@ -49,9 +50,20 @@ type PipelineScheduleParameters struct {
 	// +required
 	Description string `json:"description"`
 
-	// Ref is the branch or tag name that is triggered.
-	// +required
-	Ref string `json:"ref"`
+	// Ref is the branch or tag name that is triggered. At least 1 of [Ref,
+	// RefRef, RefSelector] required.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Branch
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.BranchName()
+	Ref string `json:"ref,omitempty"`
+
+	// RefRef is a reference to a Branch to retrieve its name as Ref.
+	// +optional
+	RefRef *xpv1.Reference `json:"refRef,omitempty"`
+
+	// RefSelector selects reference to a Branch to retrieve its name as Ref.
+	// +optional
+	RefSelector *xpv1.Selector `json:"refSelector,omitempty"`
 
 	// Cron is the cron schedule, for example: 0 1 * * *.
 	// +required
@@ -69,6 +81,12 @@ type PipelineScheduleParameters struct {
 
 	// PipelineVariables is a type of environment variable.
 	Variables []PipelineVariable `json:"variables,omitempty"`
+
+	// TakeOwnership makes the user of the ProviderConfig take ownership of
+	// the pipeline schedule whenever it is owned by another user. Gitlab
+	// deactivates schedules whose owner is blocked or removed.
+	// +optional
+	TakeOwnership *bool `json:"takeOwnership,omitempty"`
 }
 
 // PipelineScheduleObservation represents observed stated of Gitlab Pipeline Schedule.
@@ -95,8 +113,17 @@ type LastPipeline struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html
 type PipelineVariable struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key string `json:"key"`
+
+	// Value of the variable. Mutually exclusive with ValueSecretRef.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueSecretRef is used to obtain the value from a secret. The value is
+	// never written to the spec. Mutually exclusive with Value.
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+
 	// +optional
 	VariableType *string `json:"variableType,omitempty"`
 }
@@ -118,6 +145,8 @@ type PipelineScheduleStatus struct {
 // A PipelineSchedule is a managed resource that represents a Gitlab Pipeline Schedule.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LAST-PIPELINE",type="string",JSONPath=".status.atProvider.lastPipeline.status"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".status.atProvider.owner.username"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
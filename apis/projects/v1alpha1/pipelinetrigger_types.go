@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PipelineTriggerParameters define the desired state of a Gitlab pipeline
+// trigger token.
+// https://docs.gitlab.com/ee/api/pipeline_triggers.html
+type PipelineTriggerParameters struct {
+	// ProjectID is the ID of the project to create the trigger token in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Description of the trigger token, e.g. the system that uses it.
+	Description string `json:"description"`
+}
+
+// PipelineTriggerObservation represents the observed state of a Gitlab
+// pipeline trigger token.
+type PipelineTriggerObservation struct {
+	Owner     *User        `json:"owner,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	LastUsed  *metav1.Time `json:"lastUsed,omitempty"`
+}
+
+// A PipelineTriggerSpec defines the desired state of a Gitlab pipeline
+// trigger token.
+type PipelineTriggerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PipelineTriggerParameters `json:"forProvider"`
+}
+
+// A PipelineTriggerStatus represents the observed state of a Gitlab pipeline
+// trigger token.
+type PipelineTriggerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PipelineTriggerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PipelineTrigger is a managed resource that represents a Gitlab pipeline
+// trigger token. The token is published as the token connection detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".status.atProvider.owner.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type PipelineTrigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipelineTriggerSpec   `json:"spec"`
+	Status PipelineTriggerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipelineTriggerList contains a list of PipelineTrigger items
+type PipelineTriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PipelineTrigger `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 )
 
 // AccessControlValue represents an access control value within GitLab,
@@ -66,6 +68,36 @@ const (
 	RebaseMerge        MergeMethodValue = "rebase_merge"
 )
 
+// OnDeleteValue represents what happens to a GitLab project when the managed
+// resource is deleted.
+type OnDeleteValue string
+
+// List of available deletion modes
+const (
+	// OnDeleteDelete deletes the project and waits until GitLab no longer
+	// returns it.
+	OnDeleteDelete OnDeleteValue = "Delete"
+	// OnDeleteArchive archives the project instead of deleting it.
+	OnDeleteArchive OnDeleteValue = "Archive"
+	// OnDeleteDelayedDelete deletes the project and considers it gone once
+	// GitLab has marked it for delayed deletion.
+	OnDeleteDelayedDelete OnDeleteValue = "DelayedDelete"
+	// OnDeletePermanentlyRemove deletes the project and, on instances with
+	// delayed deletion, immediately removes it for good.
+	OnDeletePermanentlyRemove OnDeleteValue = "PermanentlyRemove"
+)
+
+// ImportArchive references a GitLab export archive to create a project from.
+type ImportArchive struct {
+	// URLSecretRef references the URL the archive is downloaded from,
+	// typically a presigned object store URL.
+	URLSecretRef xpv1.SecretKeySelector `json:"urlSecretRef"`
+
+	// Overwrite replaces a project that already exists at the same path.
+	// +optional
+	Overwrite *bool `json:"overwrite,omitempty"`
+}
+
 // UserIdentity represents a user identity.
 type UserIdentity struct {
 	Provider  string `json:"provider"`
@@ -150,6 +182,12 @@ type ContainerExpirationPolicyAttributes struct {
 
 // ProjectParameters define the desired state of a Gitlab Project
 type ProjectParameters struct {
+	// AdoptExisting makes the provider adopt a project that already exists
+	// at the namespace and path of this resource instead of failing to
+	// create it. Requires path and namespaceId to be set.
+	// +optional
+	AdoptExisting *bool `json:"adoptExisting,omitempty"`
+
 	// Set whether or not merge requests can be merged with skipped jobs.
 	// +optional
 	AllowMergeOnSkippedPipeline *bool `json:"allowMergeOnSkippedPipeline,omitempty"`
@@ -159,6 +197,16 @@ type ProjectParameters struct {
 	// +optional
 	ApprovalsBeforeMerge *int `json:"approvalsBeforeMerge,omitempty"`
 
+	// Set whether the project is archived. Archived projects are read-only,
+	// so no other changes are applied while the project stays archived.
+	// +optional
+	Archived *bool `json:"archived,omitempty"`
+
+	// Avatar sets the project avatar from an image stored in a ConfigMap or
+	// Secret. It is uploaded again whenever the image changes.
+	// +optional
+	Avatar *common.Avatar `json:"avatar,omitempty"`
+
 	// Auto-cancel pending pipelines. This isn’t a boolean, but enabled/disabled.
 	// +optional
 	AutoCancelPendingPipelines *string `json:"autoCancelPendingPipelines,omitempty"`
@@ -234,16 +282,43 @@ type ProjectParameters struct {
 	// +optional
 	ExternalAuthorizationClassificationLabel *string `json:"externalAuthorizationClassificationLabel,omitempty"`
 
+	// ForkedFromProjectID is the ID of the project to fork. If set, the
+	// project is created as a fork of it in namespaceId, and the remaining
+	// parameters are applied once the fork has finished importing.
+	// +optional
+	// +immutable
+	ForkedFromProjectID *int `json:"forkedFromProjectId,omitempty"`
+
+	// ForkedFromProjectIDRef is a reference to a project to retrieve its forkedFromProjectId
+	// +optional
+	// +immutable
+	ForkedFromProjectIDRef *xpv1.Reference `json:"forkedFromProjectIdRef,omitempty"`
+
+	// ForkedFromProjectIDSelector selects reference to a project to retrieve its forkedFromProjectId.
+	// +optional
+	// +immutable
+	ForkedFromProjectIDSelector *xpv1.Selector `json:"forkedFromProjectIdSelector,omitempty"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	ForkingAccessLevel *AccessControlValue `json:"forkingAccessLevel,omitempty"`
 
+	// Enable runners of the parent groups for this project.
+	// +optional
+	GroupRunnersEnabled *bool `json:"groupRunnersEnabled,omitempty"`
+
 	// For group-level custom templates, specifies ID of group from which all the custom project templates are sourced.
 	// Leave empty for instance-level templates. Requires useCustomTemplate to be true.
 	// +optional
 	// +immutable
 	GroupWithProjectTemplatesID *int `json:"groupWithProjectTemplatesId,omitempty"`
 
+	// ImportArchive creates the project from a GitLab export archive, such
+	// as one written by a ProjectExport. Requires path to be set.
+	// +optional
+	// +immutable
+	ImportArchive *ImportArchive `json:"importArchive,omitempty"`
+
 	// URL to import repository from.
 	// +optional
 	ImportURL *string `json:"importUrl,omitempty"`
@@ -296,6 +371,8 @@ type ProjectParameters struct {
 	MirrorUserID *int `json:"mirrorUserId,omitempty"`
 
 	// Namespace for the new project (defaults to the current user’s namespace).
+	// Changing it on an existing project transfers the project to the new
+	// namespace.
 	// +optional
 	NamespaceID *int `json:"namespaceId,omitempty"`
 
@@ -308,6 +385,13 @@ type ProjectParameters struct {
 	// +optional
 	NamespaceIDSelector *xpv1.Selector `json:"namespaceIdSelector,omitempty"`
 
+	// OnDelete controls what happens to the project when the managed resource
+	// is deleted with deletionPolicy Delete. One of Delete, Archive,
+	// DelayedDelete or PermanentlyRemove. Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Archive;DelayedDelete;PermanentlyRemove
+	// +optional
+	OnDelete *OnDeleteValue `json:"onDelete,omitempty"`
+
 	// Set whether merge requests can only be merged when all the discussions are resolved.
 	// +optional
 	OnlyAllowMergeIfAllDiscussionsAreResolved *bool `json:"onlyAllowMergeIfAllDiscussionsAreResolved,omitempty"`
@@ -370,6 +454,13 @@ type ProjectParameters struct {
 	// +optional
 	SharedRunnersEnabled *bool `json:"sharedRunnersEnabled,omitempty"`
 
+	// SharedWithGroups create links for sharing a project with groups.
+	// When unset, the shares of the project are not managed and shares
+	// created outside of Crossplane are kept. Set it to an empty list to
+	// remove all of them.
+	// +optional
+	SharedWithGroups []SharedWithGroupsParameters `json:"sharedWithGroups"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	SnippetsAccessLevel *AccessControlValue `json:"snippetsAccessLevel,omitempty"`
@@ -491,6 +582,33 @@ type CustomAttribute struct {
 	Value string `json:"value"`
 }
 
+// SharedWithGroupsParameters represents a group a project is shared with.
+// At least one of the fields [GroupID, GroupIDRef, GroupIDSelector] must be set.
+type SharedWithGroupsParameters struct {
+	// The ID of the group to share with.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its ID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its ID.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// The role (access_level) to grant the group
+	// https://docs.gitlab.com/ee/api/members.html#roles
+	// +required
+	// +immutable
+	GroupAccessLevel int `json:"groupAccessLevel"`
+
+	// Share expiration date in ISO 8601 format: 2016-09-26
+	// +optional
+	// +immutable
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
 // SharedWithGroups struct used in gitlab project
 type SharedWithGroups struct {
 	GroupID          int    `json:"groupID,omitempty"`
@@ -513,6 +631,7 @@ type ProjectObservation struct {
 	ForksCount                int                        `json:"forksCount,omitempty"`
 	HTTPURLToRepo             string                     `json:"httpUrlToRepo,omitempty"`
 	ImportError               string                     `json:"importError,omitempty"`
+	ImportFinished            bool                       `json:"importFinished,omitempty"`
 	ImportStatus              string                     `json:"importStatus,omitempty"`
 	IssuesEnabled             bool                       `json:"issuesEnabled,omitempty"`
 	JobsEnabled               bool                       `json:"jobsEnabled,omitempty"`
@@ -552,6 +671,11 @@ type ProjectStatus struct {
 	AtProvider          ProjectObservation `json:"atProvider,omitempty"`
 }
 
+// AnnotationKeyRecreateFailedImport can be set to "true" on a Project created
+// from a fork, an import or a template to delete and create the project again
+// when GitLab fails to copy the repository.
+const AnnotationKeyRecreateFailedImport = "gitlab.crossplane.io/recreate-failed-import"
+
 // +kubebuilder:object:root=true
 
 // A Project is a managed resource that represents a Gitlab Project
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectExportParameters define the desired state of a Gitlab project export.
+// The finished archive is either uploaded by GitLab to an URL or downloaded by
+// the provider to a volume. Exactly one of uploadUrlSecretRef and downloadPath
+// must be set.
+// https://docs.gitlab.com/ee/api/project_import_export.html#schedule-an-export
+type ProjectExportParameters struct {
+	// ProjectID is the ID of the project to export.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Description overrides the project description in the exported archive.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// UploadURLSecretRef references the URL GitLab uploads the archive to,
+	// typically a presigned object store URL.
+	// +optional
+	// +immutable
+	UploadURLSecretRef *xpv1.SecretKeySelector `json:"uploadUrlSecretRef,omitempty"`
+
+	// DownloadPath is the file the provider writes the archive to once the
+	// export has finished. To keep the archive on a PersistentVolumeClaim,
+	// mount the claim into the provider pod with a ControllerConfig and point
+	// the path into it. An existing file is left as is.
+	// +optional
+	// +immutable
+	DownloadPath *string `json:"downloadPath,omitempty"`
+
+	// UploadHTTPMethod is the HTTP method used for the upload to
+	// uploadUrlSecretRef. Defaults to PUT.
+	// +kubebuilder:validation:Enum=PUT;POST
+	// +optional
+	// +immutable
+	UploadHTTPMethod *string `json:"uploadHttpMethod,omitempty"`
+}
+
+// ProjectExportObservation represents the status of a project export.
+type ProjectExportObservation struct {
+	// ExportStatus is one of none, queued, started, finished, failed or
+	// regeneration_in_progress.
+	ExportStatus string       `json:"exportStatus,omitempty"`
+	Message      string       `json:"message,omitempty"`
+	CreatedAt    *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A ProjectExportSpec defines the desired state of a Gitlab project export.
+type ProjectExportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectExportParameters `json:"forProvider"`
+}
+
+// A ProjectExportStatus represents the observed state of a Gitlab project export.
+type ProjectExportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectExportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectExport is a managed resource that represents a Gitlab project export
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.exportStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProjectExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectExportSpec   `json:"spec"`
+	Status ProjectExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectExportList contains a list of ProjectExport items
+type ProjectExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectExport `json:"items"`
+}
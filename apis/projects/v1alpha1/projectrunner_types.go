@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectRunnerParameters define the desired state of a runner assigned to
+// a Gitlab project.
+// https://docs.gitlab.com/ee/api/runners.html#assign-a-runner-to-project
+type ProjectRunnerParameters struct {
+	// ProjectID is the ID of the project to assign the runner to.
+	// +optional
+	// +immutable
+	ProjectID *int `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// RunnerID is the ID of the project runner to assign. Group and instance
+	// runners cannot be assigned to projects.
+	// +optional
+	// +immutable
+	RunnerID *int `json:"runnerId,omitempty"`
+
+	// RunnerIDRef is a reference to a runner to retrieve its runnerId
+	// +optional
+	// +immutable
+	RunnerIDRef *xpv1.Reference `json:"runnerIdRef,omitempty"`
+
+	// RunnerIDSelector selects reference to a runner to retrieve its runnerId.
+	// +optional
+	RunnerIDSelector *xpv1.Selector `json:"runnerIdSelector,omitempty"`
+}
+
+// ProjectRunnerObservation represents the observed state of a runner
+// assigned to a Gitlab project.
+type ProjectRunnerObservation struct {
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Online      bool   `json:"online,omitempty"`
+}
+
+// A ProjectRunnerSpec defines the desired state of a runner assigned to a
+// Gitlab project.
+type ProjectRunnerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectRunnerParameters `json:"forProvider"`
+}
+
+// A ProjectRunnerStatus represents the observed state of a runner assigned
+// to a Gitlab project.
+type ProjectRunnerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectRunnerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectRunner is a managed resource that enables a project runner for
+// another Gitlab project. A runner cannot be removed from the project it was
+// created in.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="integer",JSONPath=".spec.forProvider.projectId"
+// +kubebuilder:printcolumn:name="RUNNER",type="integer",JSONPath=".spec.forProvider.runnerId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProjectRunner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectRunnerSpec   `json:"spec"`
+	Status ProjectRunnerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectRunnerList contains a list of ProjectRunner items
+type ProjectRunnerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectRunner `json:"items"`
+}
@@ -21,8 +21,10 @@ import (
 	"strconv"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	usersv1alpha1 "github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,6 +51,30 @@ func toPtrValue(v string) *int {
 	return &r
 }
 
+// ProjectID extracts the numeric ID of a Project from its status, which works
+// regardless of whether its external name is an ID or a full path.
+func ProjectID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*Project)
+		if !ok || p.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.Itoa(p.Status.AtProvider.ID)
+	}
+}
+
+// BranchName extracts the name of a Branch once it has been created, so
+// references to it only resolve when the branch exists.
+func BranchName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		b, ok := mg.(*Branch)
+		if !ok || b.Status.AtProvider.CommitSHA == "" {
+			return ""
+		}
+		return b.Spec.ForProvider.Name
+	}
+}
+
 // ResolveReferences of this Hook
 func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -59,7 +85,7 @@ func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -83,7 +109,7 @@ func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.NamespaceIDRef,
 		Selector:     mg.Spec.ForProvider.NamespaceIDSelector,
 		To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      v1alpha1.GroupID(),
 	})
 
 	if err != nil {
@@ -93,6 +119,40 @@ func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.NamespaceID = toPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.NamespaceIDRef = rsp.ResolvedReference
 
+	// resolve spec.forProvider.forkedFromProjectIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ForkedFromProjectID),
+		Reference:    mg.Spec.ForProvider.ForkedFromProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ForkedFromProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.forkedFromProjectId")
+	}
+
+	mg.Spec.ForProvider.ForkedFromProjectID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ForkedFromProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.sharedWithGroups[*].groupIdRef
+	for i := range mg.Spec.ForProvider.SharedWithGroups {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: fromPtrValue(mg.Spec.ForProvider.SharedWithGroups[i].GroupID),
+			Reference:    mg.Spec.ForProvider.SharedWithGroups[i].GroupIDRef,
+			Selector:     mg.Spec.ForProvider.SharedWithGroups[i].GroupIDSelector,
+			To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
+			Extract:      v1alpha1.GroupID(),
+		})
+
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.sharedWithGroups[%d].groupId", i)
+		}
+
+		mg.Spec.ForProvider.SharedWithGroups[i].GroupID = toPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.SharedWithGroups[i].GroupIDRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
 
@@ -106,7 +166,7 @@ func (mg *DeployToken) ResolveReferences(ctx context.Context, c client.Reader) e
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -129,7 +189,7 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      ProjectID(),
 	})
 
 	if err != nil {
@@ -139,6 +199,22 @@ func (mg *Member) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.ProjectID = toPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
 
+	// resolve spec.forProvider.userIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.UserID),
+		Reference:    mg.Spec.ForProvider.UserIDRef,
+		Selector:     mg.Spec.ForProvider.UserIDSelector,
+		To:           reference.To{Managed: &usersv1alpha1.User{}, List: &usersv1alpha1.UserList{}},
+		Extract:      usersv1alpha1.UserID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.userId")
+	}
+
+	mg.Spec.ForProvider.UserID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.UserIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -152,9 +228,110 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 		Reference:    mg.Spec.ForProvider.ProjectIDRef,
 		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
 		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	mg.Spec.ForProvider.ProjectID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Runner
+func (mg *Runner) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	mg.Spec.ForProvider.ProjectID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.groupIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.GroupID),
+		Reference:    mg.Spec.ForProvider.GroupIDRef,
+		Selector:     mg.Spec.ForProvider.GroupIDSelector,
+		To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
+		Extract:      v1alpha1.GroupID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.groupId")
+	}
+
+	mg.Spec.ForProvider.GroupID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProjectRunner
+func (mg *ProjectRunner) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	mg.Spec.ForProvider.ProjectID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.runnerIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.RunnerID),
+		Reference:    mg.Spec.ForProvider.RunnerIDRef,
+		Selector:     mg.Spec.ForProvider.RunnerIDSelector,
+		To:           reference.To{Managed: &Runner{}, List: &RunnerList{}},
 		Extract:      reference.ExternalName(),
 	})
 
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.runnerId")
+	}
+
+	mg.Spec.ForProvider.RunnerID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RunnerIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ClusterAgent
+func (mg *ClusterAgent) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.projectId")
 	}
@@ -164,3 +341,42 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ResolveReferences of this ClusterAgentToken
+func (mg *ClusterAgentToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// resolve spec.forProvider.projectIdRef
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.ProjectID),
+		Reference:    mg.Spec.ForProvider.ProjectIDRef,
+		Selector:     mg.Spec.ForProvider.ProjectIDSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      ProjectID(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.projectId")
+	}
+
+	mg.Spec.ForProvider.ProjectID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ProjectIDRef = rsp.ResolvedReference
+
+	// resolve spec.forProvider.agentIdRef
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: fromPtrValue(mg.Spec.ForProvider.AgentID),
+		Reference:    mg.Spec.ForProvider.AgentIDRef,
+		Selector:     mg.Spec.ForProvider.AgentIDSelector,
+		To:           reference.To{Managed: &ClusterAgent{}, List: &ClusterAgentList{}},
+		Extract:      reference.ExternalName(),
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.agentId")
+	}
+
+	mg.Spec.ForProvider.AgentID = toPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AgentIDRef = rsp.ResolvedReference
+
+	return nil
+}
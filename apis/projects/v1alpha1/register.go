@@ -101,6 +101,94 @@ var (
 	PipelineScheduleGroupVersionKind = SchemeGroupVersion.WithKind(PipelineScheduleKind)
 )
 
+// Project Export type metadata
+var (
+	ProjectExportKind             = reflect.TypeOf(ProjectExport{}).Name()
+	ProjectExportGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectExportKind}.String()
+	ProjectExportKindAPIVersion   = ProjectExportKind + "." + SchemeGroupVersion.String()
+	ProjectExportGroupVersionKind = SchemeGroupVersion.WithKind(ProjectExportKind)
+)
+
+// Repository File type metadata
+var (
+	RepositoryFileKind             = reflect.TypeOf(RepositoryFile{}).Name()
+	RepositoryFileGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryFileKind}.String()
+	RepositoryFileKindAPIVersion   = RepositoryFileKind + "." + SchemeGroupVersion.String()
+	RepositoryFileGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryFileKind)
+)
+
+// Branch type metadata
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
+// Tag type metadata
+var (
+	TagKind             = reflect.TypeOf(Tag{}).Name()
+	TagGroupKind        = schema.GroupKind{Group: Group, Kind: TagKind}.String()
+	TagKindAPIVersion   = TagKind + "." + SchemeGroupVersion.String()
+	TagGroupVersionKind = SchemeGroupVersion.WithKind(TagKind)
+)
+
+// Release type metadata
+var (
+	ReleaseKind             = reflect.TypeOf(Release{}).Name()
+	ReleaseGroupKind        = schema.GroupKind{Group: Group, Kind: ReleaseKind}.String()
+	ReleaseKindAPIVersion   = ReleaseKind + "." + SchemeGroupVersion.String()
+	ReleaseGroupVersionKind = SchemeGroupVersion.WithKind(ReleaseKind)
+)
+
+// Pipeline Trigger type metadata
+var (
+	PipelineTriggerKind             = reflect.TypeOf(PipelineTrigger{}).Name()
+	PipelineTriggerGroupKind        = schema.GroupKind{Group: Group, Kind: PipelineTriggerKind}.String()
+	PipelineTriggerKindAPIVersion   = PipelineTriggerKind + "." + SchemeGroupVersion.String()
+	PipelineTriggerGroupVersionKind = SchemeGroupVersion.WithKind(PipelineTriggerKind)
+)
+
+// Pipeline type metadata
+var (
+	PipelineKind             = reflect.TypeOf(Pipeline{}).Name()
+	PipelineGroupKind        = schema.GroupKind{Group: Group, Kind: PipelineKind}.String()
+	PipelineKindAPIVersion   = PipelineKind + "." + SchemeGroupVersion.String()
+	PipelineGroupVersionKind = SchemeGroupVersion.WithKind(PipelineKind)
+)
+
+// Runner type metadata
+var (
+	RunnerKind             = reflect.TypeOf(Runner{}).Name()
+	RunnerGroupKind        = schema.GroupKind{Group: Group, Kind: RunnerKind}.String()
+	RunnerKindAPIVersion   = RunnerKind + "." + SchemeGroupVersion.String()
+	RunnerGroupVersionKind = SchemeGroupVersion.WithKind(RunnerKind)
+)
+
+// Project Runner type metadata
+var (
+	ProjectRunnerKind             = reflect.TypeOf(ProjectRunner{}).Name()
+	ProjectRunnerGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectRunnerKind}.String()
+	ProjectRunnerKindAPIVersion   = ProjectRunnerKind + "." + SchemeGroupVersion.String()
+	ProjectRunnerGroupVersionKind = SchemeGroupVersion.WithKind(ProjectRunnerKind)
+)
+
+// Cluster Agent type metadata
+var (
+	ClusterAgentKind             = reflect.TypeOf(ClusterAgent{}).Name()
+	ClusterAgentGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterAgentKind}.String()
+	ClusterAgentKindAPIVersion   = ClusterAgentKind + "." + SchemeGroupVersion.String()
+	ClusterAgentGroupVersionKind = SchemeGroupVersion.WithKind(ClusterAgentKind)
+)
+
+// Cluster Agent Token type metadata
+var (
+	ClusterAgentTokenKind             = reflect.TypeOf(ClusterAgentToken{}).Name()
+	ClusterAgentTokenGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterAgentTokenKind}.String()
+	ClusterAgentTokenKindAPIVersion   = ClusterAgentTokenKind + "." + SchemeGroupVersion.String()
+	ClusterAgentTokenGroupVersionKind = SchemeGroupVersion.WithKind(ClusterAgentTokenKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&Hook{}, &HookList{})
@@ -110,4 +198,15 @@ func init() {
 	SchemeBuilder.Register(&DeployKey{}, &DeployKeyList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
 	SchemeBuilder.Register(&PipelineSchedule{}, &PipelineScheduleList{})
+	SchemeBuilder.Register(&ProjectExport{}, &ProjectExportList{})
+	SchemeBuilder.Register(&RepositoryFile{}, &RepositoryFileList{})
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
+	SchemeBuilder.Register(&Tag{}, &TagList{})
+	SchemeBuilder.Register(&Release{}, &ReleaseList{})
+	SchemeBuilder.Register(&PipelineTrigger{}, &PipelineTriggerList{})
+	SchemeBuilder.Register(&Pipeline{}, &PipelineList{})
+	SchemeBuilder.Register(&Runner{}, &RunnerList{})
+	SchemeBuilder.Register(&ProjectRunner{}, &ProjectRunnerList{})
+	SchemeBuilder.Register(&ClusterAgent{}, &ClusterAgentList{})
+	SchemeBuilder.Register(&ClusterAgentToken{}, &ClusterAgentTokenList{})
 }
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ReleaseParameters define the desired state of a Gitlab release.
+// https://docs.gitlab.com/ee/api/releases/
+type ReleaseParameters struct {
+	// ProjectID is the ID of the project the release belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// TagName is the tag the release is created for.
+	// +immutable
+	TagName string `json:"tagName"`
+
+	// Ref is the branch name or commit SHA the tag is created from if it
+	// does not exist yet.
+	// +optional
+	// +immutable
+	Ref *string `json:"ref,omitempty"`
+
+	// TagMessage creates an annotated tag when the tag does not exist yet.
+	// +optional
+	// +immutable
+	TagMessage *string `json:"tagMessage,omitempty"`
+
+	// Name of the release. Defaults to the tag name.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description of the release, Markdown is supported.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Milestones are the titles of the milestones the release is associated
+	// with. The milestones of the release are left alone when unset, an
+	// empty list removes them all.
+	// +optional
+	Milestones []string `json:"milestones"`
+
+	// ReleasedAt is the date the release is or will be ready. Defaults to
+	// the time the release is created. A date in the future creates an
+	// upcoming release.
+	// +optional
+	ReleasedAt *metav1.Time `json:"releasedAt,omitempty"`
+
+	// Links are the asset links of the release. Links not listed here are
+	// removed from the release.
+	// +optional
+	Links []ReleaseLink `json:"links,omitempty"`
+
+	// DeleteTag deletes the tag along with the release when the release is
+	// deleted. Only the release is deleted by default and the tag is kept.
+	// +optional
+	DeleteTag *bool `json:"deleteTag,omitempty"`
+}
+
+// ReleaseLink is an asset link of a release, e.g. to a package or a binary.
+type ReleaseLink struct {
+	// Name of the link, unique within the release.
+	Name string `json:"name"`
+
+	// URL the link points to.
+	URL string `json:"url"`
+
+	// FilePath is an optional path for a direct asset link, e.g.
+	// /binaries/linux-amd64.
+	// +optional
+	FilePath *string `json:"filePath,omitempty"`
+
+	// LinkType is the type of the link. Defaults to other.
+	// +kubebuilder:validation:Enum=other;runbook;image;package
+	// +optional
+	LinkType *string `json:"linkType,omitempty"`
+}
+
+// ReleaseObservation represents the observed state of a Gitlab release.
+type ReleaseObservation struct {
+	CommitSHA       string                   `json:"commitSha,omitempty"`
+	CreatedAt       *metav1.Time             `json:"createdAt,omitempty"`
+	ReleasedAt      *metav1.Time             `json:"releasedAt,omitempty"`
+	UpcomingRelease bool                     `json:"upcomingRelease,omitempty"`
+	WebURL          string                   `json:"webUrl,omitempty"`
+	Links           []ReleaseLinkObservation `json:"links,omitempty"`
+}
+
+// ReleaseLinkObservation represents the observed state of a release link.
+type ReleaseLinkObservation struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	DirectAssetURL string `json:"directAssetUrl,omitempty"`
+	LinkType       string `json:"linkType,omitempty"`
+}
+
+// A ReleaseSpec defines the desired state of a Gitlab release.
+type ReleaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReleaseParameters `json:"forProvider"`
+}
+
+// A ReleaseStatus represents the observed state of a Gitlab release.
+type ReleaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReleaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Release is a managed resource that represents a Gitlab release
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.tagName"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.webUrl"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Release struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleaseSpec   `json:"spec"`
+	Status ReleaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReleaseList contains a list of Release items
+type ReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Release `json:"items"`
+}
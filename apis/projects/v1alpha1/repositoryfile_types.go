@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// RepositoryFileParameters define the desired state of a file in a Gitlab
+// project repository.
+// https://docs.gitlab.com/ee/api/repository_files.html
+type RepositoryFileParameters struct {
+	// ProjectID is the ID of the project the file belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Branch the file is committed to.
+	// +immutable
+	Branch string `json:"branch"`
+
+	// FilePath is the path of the file within the repository, e.g.
+	// .gitlab/CODEOWNERS.
+	// +immutable
+	FilePath string `json:"filePath"`
+
+	// Content of the file. Exactly one of content and contentFrom must be
+	// set.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentFrom reads the content of the file from a ConfigMap or Secret
+	// key. Exactly one of content and contentFrom must be set.
+	// +optional
+	ContentFrom *ContentSource `json:"contentFrom,omitempty"`
+
+	// Encoding the content is sent to GitLab with, text or base64. Inline
+	// content must already be base64 encoded when set to base64. Defaults to
+	// text.
+	// +kubebuilder:validation:Enum=text;base64
+	// +optional
+	Encoding *string `json:"encoding,omitempty"`
+
+	// ExecuteFilemode enables or disables the execute flag on the file.
+	// +optional
+	ExecuteFilemode *bool `json:"executeFilemode,omitempty"`
+
+	// CommitMessage used for the commits that create, update or delete the
+	// file.
+	CommitMessage string `json:"commitMessage"`
+
+	// AuthorEmail of the commits. Defaults to the email of the authenticated
+	// user.
+	// +optional
+	AuthorEmail *string `json:"authorEmail,omitempty"`
+
+	// AuthorName of the commits. Defaults to the name of the authenticated
+	// user.
+	// +optional
+	AuthorName *string `json:"authorName,omitempty"`
+
+	// LintCIConfig validates the content through the project CI lint API
+	// before it is committed, for files holding GitLab CI configuration.
+	// Content that fails validation is never committed and is reported by
+	// the Valid condition.
+	// +optional
+	LintCIConfig *bool `json:"lintCIConfig,omitempty"`
+}
+
+// TypeValid resources report whether their content passed validation.
+const TypeValid xpv1.ConditionType = "Valid"
+
+// Reasons a resource is or is not valid.
+const (
+	ReasonValid   xpv1.ConditionReason = "ValidationSucceeded"
+	ReasonInvalid xpv1.ConditionReason = "ValidationFailed"
+)
+
+// Valid returns a condition that indicates the content of a resource passed
+// validation. The message carries any warnings.
+func Valid(warnings string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValid,
+		Message:            warnings,
+	}
+}
+
+// Invalid returns a condition that indicates the content of a resource failed
+// validation and was not applied.
+func Invalid(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInvalid,
+		Message:            msg,
+	}
+}
+
+// ContentSource references a key of a ConfigMap or Secret holding content.
+// Exactly one of configMapKeyRef and secretKeyRef must be set.
+type ContentSource struct {
+	// ConfigMapKeyRef references a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *common.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef references a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// RepositoryFileObservation represents the observed state of a repository
+// file.
+type RepositoryFileObservation struct {
+	BlobID        string `json:"blobId,omitempty"`
+	CommitID      string `json:"commitId,omitempty"`
+	LastCommitID  string `json:"lastCommitId,omitempty"`
+	ContentSHA256 string `json:"contentSha256,omitempty"`
+	Size          int    `json:"size,omitempty"`
+}
+
+// A RepositoryFileSpec defines the desired state of a Gitlab repository file.
+type RepositoryFileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryFileParameters `json:"forProvider"`
+}
+
+// A RepositoryFileStatus represents the observed state of a Gitlab repository file.
+type RepositoryFileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryFileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryFile is a managed resource that represents a file in a Gitlab
+// project repository
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".spec.forProvider.filePath"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type RepositoryFile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryFileSpec   `json:"spec"`
+	Status RepositoryFileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryFileList contains a list of RepositoryFile items
+type RepositoryFileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryFile `json:"items"`
+}
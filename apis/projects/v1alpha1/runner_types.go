@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Runner types accepted by the runner creation API.
+const (
+	InstanceTypeRunner = "instance_type"
+	GroupTypeRunner    = "group_type"
+	ProjectTypeRunner  = "project_type"
+)
+
+// RunnerParameters define the desired state of a Gitlab runner created with
+// the runner creation workflow.
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner
+type RunnerParameters struct {
+	// RunnerType is the scope of the runner. Instance runners need an
+	// administrator token.
+	// +kubebuilder:validation:Enum:=instance_type;group_type;project_type
+	// +immutable
+	RunnerType string `json:"runnerType"`
+
+	// ProjectID is the ID of the project to create a project_type runner in.
+	// +optional
+	// +immutable
+	ProjectID *int `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// GroupID is the ID of the group to create a group_type runner in.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// Description of the runner.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Paused specifies whether the runner should ignore new jobs.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+
+	// Locked specifies whether the runner should be locked for the current
+	// project.
+	// +optional
+	Locked *bool `json:"locked,omitempty"`
+
+	// RunUntagged specifies whether the runner should handle untagged jobs.
+	// +optional
+	RunUntagged *bool `json:"runUntagged,omitempty"`
+
+	// TagList is the list of tags of the runner.
+	// +optional
+	TagList []string `json:"tagList,omitempty"`
+
+	// AccessLevel of the runner.
+	// +kubebuilder:validation:Enum:=not_protected;ref_protected
+	// +optional
+	AccessLevel *string `json:"accessLevel,omitempty"`
+
+	// MaximumTimeout is the maximum timeout in seconds that limits the amount
+	// of time runners can run jobs.
+	// +optional
+	MaximumTimeout *int `json:"maximumTimeout,omitempty"`
+}
+
+// RunnerObservation represents the observed state of a Gitlab runner.
+type RunnerObservation struct {
+	Status       string       `json:"status,omitempty"`
+	Online       bool         `json:"online,omitempty"`
+	IPAddress    string       `json:"ipAddress,omitempty"`
+	Version      string       `json:"version,omitempty"`
+	Platform     string       `json:"platform,omitempty"`
+	Architecture string       `json:"architecture,omitempty"`
+	ContactedAt  *metav1.Time `json:"contactedAt,omitempty"`
+}
+
+// A RunnerSpec defines the desired state of a Gitlab runner.
+type RunnerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RunnerParameters `json:"forProvider"`
+}
+
+// A RunnerStatus represents the observed state of a Gitlab runner.
+type RunnerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RunnerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Runner is a managed resource that represents a Gitlab runner. Its
+// authentication token is published as the token and runner-token connection
+// details, so the connection secret can be used by the gitlab-runner Helm
+// chart. GitLab only returns the token when the runner is created, so the
+// token is reset when the connection secret is missing or lacks it.
+//
+// Runners of every type live in the projects API group next to the other CI
+// resources, such as pipeline schedules and project runners, since the
+// provider has no API group for instance level resources. Group runners refer
+// to their group by reference, like projects do for their namespace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.runnerType"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Runner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RunnerSpec   `json:"spec"`
+	Status RunnerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RunnerList contains a list of Runner items
+type RunnerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Runner `json:"items"`
+}
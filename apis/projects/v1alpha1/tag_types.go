@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TagParameters define the desired state of a Gitlab repository tag.
+// https://docs.gitlab.com/ee/api/tags.html
+type TagParameters struct {
+	// ProjectID is the ID of the project the tag belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProjectID()
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the tag, e.g. v1.0.0.
+	// +immutable
+	Name string `json:"name"`
+
+	// Ref is the branch name or commit SHA the tag points to.
+	// +immutable
+	Ref string `json:"ref"`
+
+	// Message creates an annotated tag when set.
+	// +optional
+	// +immutable
+	Message *string `json:"message,omitempty"`
+}
+
+// TagObservation represents the observed state of a Gitlab tag.
+type TagObservation struct {
+	CommitSHA string `json:"commitSha,omitempty"`
+	Target    string `json:"target,omitempty"`
+	Protected bool   `json:"protected,omitempty"`
+}
+
+// A TagSpec defines the desired state of a Gitlab tag.
+type TagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagParameters `json:"forProvider"`
+}
+
+// A TagStatus represents the observed state of a Gitlab tag.
+type TagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Tag is a managed resource that represents a Gitlab repository tag
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="SHA",type="string",JSONPath=".status.atProvider.commitSha"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Tag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagSpec   `json:"spec"`
+	Status TagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagList contains a list of Tag items
+type TagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tag `json:"items"`
+}
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -184,91 +185,7 @@ func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
-	*out = *in
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
-func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
-	if in == nil {
-		return nil
-	}
-	out := new(ContainerExpirationPolicy)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
-	*out = *in
-	if in.Cadence != nil {
-		in, out := &in.Cadence, &out.Cadence
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeepN != nil {
-		in, out := &in.KeepN, &out.KeepN
-		*out = new(int)
-		**out = **in
-	}
-	if in.OlderThan != nil {
-		in, out := &in.OlderThan, &out.OlderThan
-		*out = new(string)
-		**out = **in
-	}
-	if in.NameRegexDelete != nil {
-		in, out := &in.NameRegexDelete, &out.NameRegexDelete
-		*out = new(string)
-		**out = **in
-	}
-	if in.NameRegexKeep != nil {
-		in, out := &in.NameRegexKeep, &out.NameRegexKeep
-		*out = new(string)
-		**out = **in
-	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NameRegex != nil {
-		in, out := &in.NameRegex, &out.NameRegex
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
-func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
-	if in == nil {
-		return nil
-	}
-	out := new(ContainerExpirationPolicyAttributes)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
-func (in *CustomAttribute) DeepCopy() *CustomAttribute {
-	if in == nil {
-		return nil
-	}
-	out := new(CustomAttribute)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKey) DeepCopyInto(out *DeployKey) {
+func (in *Branch) DeepCopyInto(out *Branch) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -276,18 +193,18 @@ func (in *DeployKey) DeepCopyInto(out *DeployKey) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
-func (in *DeployKey) DeepCopy() *DeployKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Branch.
+func (in *Branch) DeepCopy() *Branch {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKey)
+	out := new(Branch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKey) DeepCopyObject() runtime.Object {
+func (in *Branch) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -295,31 +212,31 @@ func (in *DeployKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
+func (in *BranchList) DeepCopyInto(out *BranchList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployKey, len(*in))
+		*out = make([]Branch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
-func (in *DeployKeyList) DeepCopy() *DeployKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
+func (in *BranchList) DeepCopy() *BranchList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyList)
+	out := new(BranchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKeyList) DeepCopyObject() runtime.Object {
+func (in *BranchList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -327,31 +244,22 @@ func (in *DeployKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
+func (in *BranchObservation) DeepCopyInto(out *BranchObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int)
-		**out = **in
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
-func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchObservation.
+func (in *BranchObservation) DeepCopy() *BranchObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyObservation)
+	out := new(BranchObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
+func (in *BranchParameters) DeepCopyInto(out *BranchParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -368,64 +276,54 @@ func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CanPush != nil {
-		in, out := &in.CanPush, &out.CanPush
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
-	}
-	out.KeySecretRef = in.KeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
-func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchParameters.
+func (in *BranchParameters) DeepCopy() *BranchParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyParameters)
+	out := new(BranchParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
+func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
-func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
+func (in *BranchSpec) DeepCopy() *BranchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeySpec)
+	out := new(BranchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
+func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
-func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
+func (in *BranchStatus) DeepCopy() *BranchStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyStatus)
+	out := new(BranchStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployToken) DeepCopyInto(out *DeployToken) {
+func (in *ClusterAgent) DeepCopyInto(out *ClusterAgent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -433,18 +331,18 @@ func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
-func (in *DeployToken) DeepCopy() *DeployToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgent.
+func (in *ClusterAgent) DeepCopy() *ClusterAgent {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployToken)
+	out := new(ClusterAgent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployToken) DeepCopyObject() runtime.Object {
+func (in *ClusterAgent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -452,31 +350,31 @@ func (in *DeployToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
+func (in *ClusterAgentList) DeepCopyInto(out *ClusterAgentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployToken, len(*in))
+		*out = make([]ClusterAgent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
-func (in *DeployTokenList) DeepCopy() *DeployTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentList.
+func (in *ClusterAgentList) DeepCopy() *ClusterAgentList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenList)
+	out := new(ClusterAgentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployTokenList) DeepCopyObject() runtime.Object {
+func (in *ClusterAgentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -484,22 +382,26 @@ func (in *DeployTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
+func (in *ClusterAgentObservation) DeepCopyInto(out *ClusterAgentObservation) {
 	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
-func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentObservation.
+func (in *ClusterAgentObservation) DeepCopy() *ClusterAgentObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenObservation)
+	out := new(ClusterAgentObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
+func (in *ClusterAgentParameters) DeepCopyInto(out *ClusterAgentParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -516,98 +418,54 @@ func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
-		*out = new(string)
-		**out = **in
-	}
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
-func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentParameters.
+func (in *ClusterAgentParameters) DeepCopy() *ClusterAgentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenParameters)
+	out := new(ClusterAgentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
+func (in *ClusterAgentSpec) DeepCopyInto(out *ClusterAgentSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
-func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentSpec.
+func (in *ClusterAgentSpec) DeepCopy() *ClusterAgentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenSpec)
+	out := new(ClusterAgentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
+func (in *ClusterAgentStatus) DeepCopyInto(out *ClusterAgentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
-func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(DeployTokenStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ForkParent) DeepCopyInto(out *ForkParent) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
-func (in *ForkParent) DeepCopy() *ForkParent {
-	if in == nil {
-		return nil
-	}
-	out := new(ForkParent)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
-	*out = *in
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
-func (in *GroupAccess) DeepCopy() *GroupAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentStatus.
+func (in *ClusterAgentStatus) DeepCopy() *ClusterAgentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAccess)
+	out := new(ClusterAgentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hook) DeepCopyInto(out *Hook) {
+func (in *ClusterAgentToken) DeepCopyInto(out *ClusterAgentToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -615,18 +473,18 @@ func (in *Hook) DeepCopyInto(out *Hook) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
-func (in *Hook) DeepCopy() *Hook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentToken.
+func (in *ClusterAgentToken) DeepCopy() *ClusterAgentToken {
 	if in == nil {
 		return nil
 	}
-	out := new(Hook)
+	out := new(ClusterAgentToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Hook) DeepCopyObject() runtime.Object {
+func (in *ClusterAgentToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -634,31 +492,31 @@ func (in *Hook) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookList) DeepCopyInto(out *HookList) {
+func (in *ClusterAgentTokenList) DeepCopyInto(out *ClusterAgentTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Hook, len(*in))
+		*out = make([]ClusterAgentToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
-func (in *HookList) DeepCopy() *HookList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentTokenList.
+func (in *ClusterAgentTokenList) DeepCopy() *ClusterAgentTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(HookList)
+	out := new(ClusterAgentTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HookList) DeepCopyObject() runtime.Object {
+func (in *ClusterAgentTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -666,37 +524,31 @@ func (in *HookList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookObservation) DeepCopyInto(out *HookObservation) {
+func (in *ClusterAgentTokenObservation) DeepCopyInto(out *ClusterAgentTokenObservation) {
 	*out = *in
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
-func (in *HookObservation) DeepCopy() *HookObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentTokenObservation.
+func (in *ClusterAgentTokenObservation) DeepCopy() *ClusterAgentTokenObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(HookObservation)
+	out := new(ClusterAgentTokenObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookParameters) DeepCopyInto(out *HookParameters) {
+func (in *ClusterAgentTokenParameters) DeepCopyInto(out *ClusterAgentTokenParameters) {
 	*out = *in
-	if in.URL != nil {
-		in, out := &in.URL, &out.URL
-		*out = new(string)
-		**out = **in
-	}
-	if in.ConfidentialNoteEvents != nil {
-		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
-		*out = new(bool)
-		**out = **in
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(int)
@@ -712,144 +564,183 @@ func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PushEvents != nil {
-		in, out := &in.PushEvents, &out.PushEvents
-		*out = new(bool)
+	if in.AgentID != nil {
+		in, out := &in.AgentID, &out.AgentID
+		*out = new(int)
 		**out = **in
 	}
-	if in.PushEventsBranchFilter != nil {
-		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
-		*out = new(string)
-		**out = **in
+	if in.AgentIDRef != nil {
+		in, out := &in.AgentIDRef, &out.AgentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.IssuesEvents != nil {
-		in, out := &in.IssuesEvents, &out.IssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ConfidentialIssuesEvents != nil {
-		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MergeRequestsEvents != nil {
-		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TagPushEvents != nil {
-		in, out := &in.TagPushEvents, &out.TagPushEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NoteEvents != nil {
-		in, out := &in.NoteEvents, &out.NoteEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.JobEvents != nil {
-		in, out := &in.JobEvents, &out.JobEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PipelineEvents != nil {
-		in, out := &in.PipelineEvents, &out.PipelineEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.WikiPageEvents != nil {
-		in, out := &in.WikiPageEvents, &out.WikiPageEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableSSLVerification != nil {
-		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(string)
+	if in.AgentIDSelector != nil {
+		in, out := &in.AgentIDSelector, &out.AgentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
-func (in *HookParameters) DeepCopy() *HookParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentTokenParameters.
+func (in *ClusterAgentTokenParameters) DeepCopy() *ClusterAgentTokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(HookParameters)
+	out := new(ClusterAgentTokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+func (in *ClusterAgentTokenSpec) DeepCopyInto(out *ClusterAgentTokenSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
-func (in *HookSpec) DeepCopy() *HookSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentTokenSpec.
+func (in *ClusterAgentTokenSpec) DeepCopy() *ClusterAgentTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HookSpec)
+	out := new(ClusterAgentTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+func (in *ClusterAgentTokenStatus) DeepCopyInto(out *ClusterAgentTokenStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
-func (in *HookStatus) DeepCopy() *HookStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAgentTokenStatus.
+func (in *ClusterAgentTokenStatus) DeepCopy() *ClusterAgentTokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HookStatus)
+	out := new(ClusterAgentTokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
 	*out = *in
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
-func (in *LastPipeline) DeepCopy() *LastPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
+func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(LastPipeline)
+	out := new(ContainerExpirationPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Links) DeepCopyInto(out *Links) {
+func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+	*out = *in
+	if in.Cadence != nil {
+		in, out := &in.Cadence, &out.Cadence
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeepN != nil {
+		in, out := &in.KeepN, &out.KeepN
+		*out = new(int)
+		**out = **in
+	}
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexDelete != nil {
+		in, out := &in.NameRegexDelete, &out.NameRegexDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexKeep != nil {
+		in, out := &in.NameRegexKeep, &out.NameRegexKeep
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NameRegex != nil {
+		in, out := &in.NameRegex, &out.NameRegex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
+func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerExpirationPolicyAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentSource) DeepCopyInto(out *ContentSource) {
 	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(common.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
-func (in *Links) DeepCopy() *Links {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSource.
+func (in *ContentSource) DeepCopy() *ContentSource {
 	if in == nil {
 		return nil
 	}
-	out := new(Links)
+	out := new(ContentSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
+func (in *CustomAttribute) DeepCopy() *CustomAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployKey) DeepCopyInto(out *DeployKey) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -857,18 +748,18 @@ func (in *Member) DeepCopyInto(out *Member) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
+func (in *DeployKey) DeepCopy() *DeployKey {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(DeployKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Member) DeepCopyObject() runtime.Object {
+func (in *DeployKey) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -876,31 +767,31 @@ func (in *Member) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
+func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Member, len(*in))
+		*out = make([]DeployKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
+func (in *DeployKeyList) DeepCopy() *DeployKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(DeployKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemberList) DeepCopyObject() runtime.Object {
+func (in *DeployKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -908,30 +799,35 @@ func (in *MemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
 	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
-func (in *MemberObservation) DeepCopy() *MemberObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
+func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberObservation)
+	out := new(DeployKeyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -944,94 +840,64 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int)
-		**out = **in
-	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
-		*out = new(string)
+	if in.CanPush != nil {
+		in, out := &in.CanPush, &out.CanPush
+		*out = new(bool)
 		**out = **in
 	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = new(string)
-		**out = **in
+		*out = (*in).DeepCopy()
 	}
+	out.KeySecretRef = in.KeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
-func (in *MemberParameters) DeepCopy() *MemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
+func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberParameters)
+	out := new(DeployKeyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
-func (in *MemberSpec) DeepCopy() *MemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
+func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSpec)
+	out := new(DeployKeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
-func (in *MemberStatus) DeepCopy() *MemberStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(MemberStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Permissions) DeepCopyInto(out *Permissions) {
-	*out = *in
-	if in.ProjectAccess != nil {
-		in, out := &in.ProjectAccess, &out.ProjectAccess
-		*out = new(ProjectAccess)
-		**out = **in
-	}
-	if in.GroupAccess != nil {
-		in, out := &in.GroupAccess, &out.GroupAccess
-		*out = new(GroupAccess)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
-func (in *Permissions) DeepCopy() *Permissions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
+func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Permissions)
+	out := new(DeployKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1039,18 +905,18 @@ func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
-func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
+func (in *DeployToken) DeepCopy() *DeployToken {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineSchedule)
+	out := new(DeployToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+func (in *DeployToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1058,31 +924,31 @@ func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PipelineSchedule, len(*in))
+		*out = make([]DeployToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
-func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
+func (in *DeployTokenList) DeepCopy() *DeployTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleList)
+	out := new(DeployTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+func (in *DeployTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1090,53 +956,26 @@ func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int)
-		**out = **in
-	}
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LastPipeline != nil {
-		in, out := &in.LastPipeline, &out.LastPipeline
-		*out = new(LastPipeline)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
-func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
+func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleObservation)
+	out := new(DeployTokenObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(string)
+		*out = new(int)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -1149,172 +988,149 @@ func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CronTimezone != nil {
-		in, out := &in.CronTimezone, &out.CronTimezone
-		*out = new(string)
-		**out = **in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
 		**out = **in
 	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make([]PipelineVariable, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
-func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
+func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleParameters)
+	out := new(DeployTokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
-func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
+func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleSpec)
+	out := new(DeployTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
-func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
+func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleStatus)
+	out := new(DeployTokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+func (in *ForkParent) DeepCopyInto(out *ForkParent) {
 	*out = *in
-	if in.VariableType != nil {
-		in, out := &in.VariableType, &out.VariableType
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
-func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
+func (in *ForkParent) DeepCopy() *ForkParent {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineVariable)
+	out := new(ForkParent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
+func (in *GroupAccess) DeepCopy() *GroupAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(GroupAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+func (in *Hook) DeepCopyInto(out *Hook) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
-func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectAccess)
+	out := new(Hook)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
-func (in *ProjectLicense) DeepCopy() *ProjectLicense {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Hook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ProjectLicense)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *HookList) DeepCopyInto(out *HookList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+		*out = make([]Hook, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
+func (in *HookList) DeepCopy() *HookList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(HookList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
+func (in *HookList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1322,478 +1138,2521 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+func (in *HookObservation) DeepCopyInto(out *HookObservation) {
 	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
-func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
+func (in *HookObservation) DeepCopy() *HookObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectNamespace)
+	out := new(HookObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 	*out = *in
-	if in.ComplianceFrameworks != nil {
-		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
 	}
-	if in.ContainerExpirationPolicy != nil {
-		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
-		*out = new(ContainerExpirationPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.ConfidentialNoteEvents != nil {
+		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
+		*out = new(bool)
+		**out = **in
 	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
 	}
-	if in.CustomAttributes != nil {
-		in, out := &in.CustomAttributes, &out.CustomAttributes
-		*out = make([]CustomAttribute, len(*in))
-		copy(*out, *in)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ForkedFromProject != nil {
-		in, out := &in.ForkedFromProject, &out.ForkedFromProject
-		*out = new(ForkParent)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LastActivityAt != nil {
-		in, out := &in.LastActivityAt, &out.LastActivityAt
-		*out = (*in).DeepCopy()
+	if in.PushEvents != nil {
+		in, out := &in.PushEvents, &out.PushEvents
+		*out = new(bool)
+		**out = **in
 	}
-	if in.License != nil {
-		in, out := &in.License, &out.License
-		*out = new(ProjectLicense)
+	if in.PushEventsBranchFilter != nil {
+		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
+		*out = new(string)
 		**out = **in
 	}
-	if in.Links != nil {
-		in, out := &in.Links, &out.Links
-		*out = new(Links)
+	if in.IssuesEvents != nil {
+		in, out := &in.IssuesEvents, &out.IssuesEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MarkedForDeletionAt != nil {
-		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
-		*out = (*in).DeepCopy()
+	if in.ConfidentialIssuesEvents != nil {
+		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(ProjectNamespace)
+	if in.MergeRequestsEvents != nil {
+		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
+	if in.TagPushEvents != nil {
+		in, out := &in.TagPushEvents, &out.TagPushEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NoteEvents != nil {
+		in, out := &in.NoteEvents, &out.NoteEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JobEvents != nil {
+		in, out := &in.JobEvents, &out.JobEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PipelineEvents != nil {
+		in, out := &in.PipelineEvents, &out.PipelineEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WikiPageEvents != nil {
+		in, out := &in.WikiPageEvents, &out.WikiPageEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSSLVerification != nil {
+		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
+func (in *HookParameters) DeepCopy() *HookParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HookParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
+func (in *HookSpec) DeepCopy() *HookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportArchive) DeepCopyInto(out *ImportArchive) {
+	*out = *in
+	out.URLSecretRef = in.URLSecretRef
+	if in.Overwrite != nil {
+		in, out := &in.Overwrite, &out.Overwrite
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportArchive.
+func (in *ImportArchive) DeepCopy() *ImportArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
+func (in *LastPipeline) DeepCopy() *LastPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(LastPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Links) DeepCopyInto(out *Links) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
+func (in *Links) DeepCopy() *Links {
+	if in == nil {
+		return nil
+	}
+	out := new(Links)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Member) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserIDRef != nil {
+		in, out := &in.UserIDRef, &out.UserIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserIDSelector != nil {
+		in, out := &in.UserIDSelector, &out.UserIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permissions) DeepCopyInto(out *Permissions) {
+	*out = *in
+	if in.ProjectAccess != nil {
+		in, out := &in.ProjectAccess, &out.ProjectAccess
+		*out = new(ProjectAccess)
+		**out = **in
+	}
+	if in.GroupAccess != nil {
+		in, out := &in.GroupAccess, &out.GroupAccess
+		*out = new(GroupAccess)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
+func (in *Permissions) DeepCopy() *Permissions {
+	if in == nil {
+		return nil
+	}
+	out := new(Permissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Pipeline) DeepCopyInto(out *Pipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pipeline.
+func (in *Pipeline) DeepCopy() *Pipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(Pipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Pipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineJob) DeepCopyInto(out *PipelineJob) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineJob.
+func (in *PipelineJob) DeepCopy() *PipelineJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineList) DeepCopyInto(out *PipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Pipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineList.
+func (in *PipelineList) DeepCopy() *PipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineObservation) DeepCopyInto(out *PipelineObservation) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]PipelineJob, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineObservation.
+func (in *PipelineObservation) DeepCopy() *PipelineObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineParameters) DeepCopyInto(out *PipelineParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefRef != nil {
+		in, out := &in.RefRef, &out.RefRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefSelector != nil {
+		in, out := &in.RefSelector, &out.RefSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PipelineVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineParameters.
+func (in *PipelineParameters) DeepCopy() *PipelineParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
+func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
+func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPipeline != nil {
+		in, out := &in.LastPipeline, &out.LastPipeline
+		*out = new(LastPipeline)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
+func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefRef != nil {
+		in, out := &in.RefRef, &out.RefRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefSelector != nil {
+		in, out := &in.RefSelector, &out.RefSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronTimezone != nil {
+		in, out := &in.CronTimezone, &out.CronTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PipelineVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TakeOwnership != nil {
+		in, out := &in.TakeOwnership, &out.TakeOwnership
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
+func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
+func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
+func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
+func (in *PipelineSpec) DeepCopy() *PipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStatus.
+func (in *PipelineStatus) DeepCopy() *PipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTrigger) DeepCopyInto(out *PipelineTrigger) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTrigger.
+func (in *PipelineTrigger) DeepCopy() *PipelineTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineTrigger) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTriggerList) DeepCopyInto(out *PipelineTriggerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTriggerList.
+func (in *PipelineTriggerList) DeepCopy() *PipelineTriggerList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTriggerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineTriggerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTriggerObservation) DeepCopyInto(out *PipelineTriggerObservation) {
+	*out = *in
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUsed != nil {
+		in, out := &in.LastUsed, &out.LastUsed
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTriggerObservation.
+func (in *PipelineTriggerObservation) DeepCopy() *PipelineTriggerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTriggerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTriggerParameters) DeepCopyInto(out *PipelineTriggerParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTriggerParameters.
+func (in *PipelineTriggerParameters) DeepCopy() *PipelineTriggerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTriggerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTriggerSpec) DeepCopyInto(out *PipelineTriggerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTriggerSpec.
+func (in *PipelineTriggerSpec) DeepCopy() *PipelineTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTriggerStatus) DeepCopyInto(out *PipelineTriggerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTriggerStatus.
+func (in *PipelineTriggerStatus) DeepCopy() *PipelineTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+	*out = *in
+	if in.ValueSecretRef != nil {
+		in, out := &in.ValueSecretRef, &out.ValueSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.VariableType != nil {
+		in, out := &in.VariableType, &out.VariableType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
+func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
+func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExport) DeepCopyInto(out *ProjectExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExport.
+func (in *ProjectExport) DeepCopy() *ProjectExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportList) DeepCopyInto(out *ProjectExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportList.
+func (in *ProjectExportList) DeepCopy() *ProjectExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportObservation) DeepCopyInto(out *ProjectExportObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportObservation.
+func (in *ProjectExportObservation) DeepCopy() *ProjectExportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportParameters) DeepCopyInto(out *ProjectExportParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadURLSecretRef != nil {
+		in, out := &in.UploadURLSecretRef, &out.UploadURLSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.DownloadPath != nil {
+		in, out := &in.DownloadPath, &out.DownloadPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadHTTPMethod != nil {
+		in, out := &in.UploadHTTPMethod, &out.UploadHTTPMethod
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportParameters.
+func (in *ProjectExportParameters) DeepCopy() *ProjectExportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportSpec) DeepCopyInto(out *ProjectExportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportSpec.
+func (in *ProjectExportSpec) DeepCopy() *ProjectExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExportStatus) DeepCopyInto(out *ProjectExportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExportStatus.
+func (in *ProjectExportStatus) DeepCopy() *ProjectExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
+func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
+func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+	*out = *in
+	if in.ComplianceFrameworks != nil {
+		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContainerExpirationPolicy != nil {
+		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
+		*out = new(ContainerExpirationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]CustomAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForkedFromProject != nil {
+		in, out := &in.ForkedFromProject, &out.ForkedFromProject
+		*out = new(ForkParent)
+		**out = **in
+	}
+	if in.LastActivityAt != nil {
+		in, out := &in.LastActivityAt, &out.LastActivityAt
+		*out = (*in).DeepCopy()
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(ProjectLicense)
+		**out = **in
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = new(Links)
+		**out = **in
+	}
+	if in.MarkedForDeletionAt != nil {
+		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(ProjectNamespace)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
 		*out = new(User)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = new(Permissions)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(Permissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroups, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(ProjectStatistics)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
+func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+	*out = *in
+	if in.AdoptExisting != nil {
+		in, out := &in.AdoptExisting, &out.AdoptExisting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowMergeOnSkippedPipeline != nil {
+		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApprovalsBeforeMerge != nil {
+		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
+		*out = new(int)
+		**out = **in
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Avatar != nil {
+		in, out := &in.Avatar, &out.Avatar
+		*out = new(common.Avatar)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoCancelPendingPipelines != nil {
+		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsDeployStrategy != nil {
+		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsEnabled != nil {
+		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutocloseReferencedIssues != nil {
+		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BuildCoverageRegex != nil {
+		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildGitStrategy != nil {
+		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildTimeout != nil {
+		in, out := &in.BuildTimeout, &out.BuildTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.BuildsAccessLevel != nil {
+		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.CIConfigPath != nil {
+		in, out := &in.CIConfigPath, &out.CIConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDefaultGitDepth != nil {
+		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
+		*out = new(int)
+		**out = **in
+	}
+	if in.CIForwardDeploymentEnabled != nil {
+		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerExpirationPolicyAttributes != nil {
+		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
+		*out = new(ContainerExpirationPolicyAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRegistryEnabled != nil {
+		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailsDisabled != nil {
+		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalAuthorizationClassificationLabel != nil {
+		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForkedFromProjectID != nil {
+		in, out := &in.ForkedFromProjectID, &out.ForkedFromProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ForkedFromProjectIDRef != nil {
+		in, out := &in.ForkedFromProjectIDRef, &out.ForkedFromProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkedFromProjectIDSelector != nil {
+		in, out := &in.ForkedFromProjectIDSelector, &out.ForkedFromProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkingAccessLevel != nil {
+		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.GroupRunnersEnabled != nil {
+		in, out := &in.GroupRunnersEnabled, &out.GroupRunnersEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GroupWithProjectTemplatesID != nil {
+		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ImportArchive != nil {
+		in, out := &in.ImportArchive, &out.ImportArchive
+		*out = new(ImportArchive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImportURL != nil {
+		in, out := &in.ImportURL, &out.ImportURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitializeWithReadme != nil {
+		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IssuesAccessLevel != nil {
+		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.IssuesTemplate != nil {
+		in, out := &in.IssuesTemplate, &out.IssuesTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.LFSEnabled != nil {
+		in, out := &in.LFSEnabled, &out.LFSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeMethod != nil {
+		in, out := &in.MergeMethod, &out.MergeMethod
+		*out = new(MergeMethodValue)
+		**out = **in
+	}
+	if in.MergeRequestsAccessLevel != nil {
+		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.MergeRequestsTemplate != nil {
+		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorOverwritesDivergedBranches != nil {
+		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorTriggerBuilds != nil {
+		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorUserID != nil {
+		in, out := &in.MirrorUserID, &out.MirrorUserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDelete != nil {
+		in, out := &in.OnDelete, &out.OnDelete
+		*out = new(OnDeleteValue)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
+		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
+		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyMirrorProtectedBranches != nil {
+		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OperationsAccessLevel != nil {
+		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.PackagesEnabled != nil {
+		in, out := &in.PackagesEnabled, &out.PackagesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PagesAccessLevel != nil {
+		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrintingMergeRequestLinkEnabled != nil {
+		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicBuilds != nil {
+		in, out := &in.PublicBuilds, &out.PublicBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveSourceBranchAfterMerge != nil {
+		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RepositoryAccessLevel != nil {
+		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.RequestAccessEnabled != nil {
+		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResolveOutdatedDiffDiscussions != nil {
+		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceDeskEnabled != nil {
+		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedRunnersEnabled != nil {
+		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroupsParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnippetsAccessLevel != nil {
+		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.SuggestionCommitMessage != nil {
+		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateProjectID != nil {
+		in, out := &in.TemplateProjectID, &out.TemplateProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseCustomTemplate != nil {
+		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.WikiAccessLevel != nil {
+		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
+func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunner) DeepCopyInto(out *ProjectRunner) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunner.
+func (in *ProjectRunner) DeepCopy() *ProjectRunner {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRunner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunnerList) DeepCopyInto(out *ProjectRunnerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectRunner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunnerList.
+func (in *ProjectRunnerList) DeepCopy() *ProjectRunnerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunnerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRunnerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunnerObservation) DeepCopyInto(out *ProjectRunnerObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunnerObservation.
+func (in *ProjectRunnerObservation) DeepCopy() *ProjectRunnerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunnerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunnerParameters) DeepCopyInto(out *ProjectRunnerParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunnerID != nil {
+		in, out := &in.RunnerID, &out.RunnerID
+		*out = new(int)
+		**out = **in
+	}
+	if in.RunnerIDRef != nil {
+		in, out := &in.RunnerIDRef, &out.RunnerIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunnerIDSelector != nil {
+		in, out := &in.RunnerIDSelector, &out.RunnerIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunnerParameters.
+func (in *ProjectRunnerParameters) DeepCopy() *ProjectRunnerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunnerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunnerSpec) DeepCopyInto(out *ProjectRunnerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunnerSpec.
+func (in *ProjectRunnerSpec) DeepCopy() *ProjectRunnerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunnerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRunnerStatus) DeepCopyInto(out *ProjectRunnerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRunnerStatus.
+func (in *ProjectRunnerStatus) DeepCopy() *ProjectRunnerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRunnerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+	*out = *in
+	out.StorageStatistics = in.StorageStatistics
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
+func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Release) DeepCopyInto(out *Release) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Release.
+func (in *Release) DeepCopy() *Release {
+	if in == nil {
+		return nil
+	}
+	out := new(Release)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Release) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseLink) DeepCopyInto(out *ReleaseLink) {
+	*out = *in
+	if in.FilePath != nil {
+		in, out := &in.FilePath, &out.FilePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkType != nil {
+		in, out := &in.LinkType, &out.LinkType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseLink.
+func (in *ReleaseLink) DeepCopy() *ReleaseLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseLinkObservation) DeepCopyInto(out *ReleaseLinkObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseLinkObservation.
+func (in *ReleaseLinkObservation) DeepCopy() *ReleaseLinkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseLinkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseList) DeepCopyInto(out *ReleaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Release, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseList.
+func (in *ReleaseList) DeepCopy() *ReleaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseObservation) DeepCopyInto(out *ReleaseObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ReleasedAt != nil {
+		in, out := &in.ReleasedAt, &out.ReleasedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ReleaseLinkObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseObservation.
+func (in *ReleaseObservation) DeepCopy() *ReleaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseParameters) DeepCopyInto(out *ReleaseParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SharedWithGroups != nil {
-		in, out := &in.SharedWithGroups, &out.SharedWithGroups
-		*out = make([]SharedWithGroups, len(*in))
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagMessage != nil {
+		in, out := &in.TagMessage, &out.TagMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Milestones != nil {
+		in, out := &in.Milestones, &out.Milestones
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(ProjectStatistics)
+	if in.ReleasedAt != nil {
+		in, out := &in.ReleasedAt, &out.ReleasedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ReleaseLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeleteTag != nil {
+		in, out := &in.DeleteTag, &out.DeleteTag
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
-func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseParameters.
+func (in *ReleaseParameters) DeepCopy() *ReleaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseSpec) DeepCopyInto(out *ReleaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseSpec.
+func (in *ReleaseSpec) DeepCopy() *ReleaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseStatus) DeepCopyInto(out *ReleaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseStatus.
+func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFile) DeepCopyInto(out *RepositoryFile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFile.
+func (in *RepositoryFile) DeepCopy() *RepositoryFile {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryFile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileList) DeepCopyInto(out *RepositoryFileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileList.
+func (in *RepositoryFileList) DeepCopy() *RepositoryFileList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryFileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileObservation) DeepCopyInto(out *RepositoryFileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileObservation.
+func (in *RepositoryFileObservation) DeepCopy() *RepositoryFileObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectObservation)
+	out := new(RepositoryFileObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+func (in *RepositoryFileParameters) DeepCopyInto(out *RepositoryFileParameters) {
 	*out = *in
-	if in.AllowMergeOnSkippedPipeline != nil {
-		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ApprovalsBeforeMerge != nil {
-		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
-		*out = new(int)
-		**out = **in
-	}
-	if in.AutoCancelPendingPipelines != nil {
-		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
-		*out = new(string)
-		**out = **in
-	}
-	if in.AutoDevopsDeployStrategy != nil {
-		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
-		*out = new(string)
-		**out = **in
-	}
-	if in.AutoDevopsEnabled != nil {
-		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AutocloseReferencedIssues != nil {
-		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BuildCoverageRegex != nil {
-		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
-		*out = new(string)
-		**out = **in
-	}
-	if in.BuildGitStrategy != nil {
-		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
-		*out = new(string)
-		**out = **in
-	}
-	if in.BuildTimeout != nil {
-		in, out := &in.BuildTimeout, &out.BuildTimeout
-		*out = new(int)
-		**out = **in
-	}
-	if in.BuildsAccessLevel != nil {
-		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.CIConfigPath != nil {
-		in, out := &in.CIConfigPath, &out.CIConfigPath
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CIDefaultGitDepth != nil {
-		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
-		*out = new(int)
-		**out = **in
-	}
-	if in.CIForwardDeploymentEnabled != nil {
-		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ContainerExpirationPolicyAttributes != nil {
-		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
-		*out = new(ContainerExpirationPolicyAttributes)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ContainerRegistryEnabled != nil {
-		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.DefaultBranch != nil {
-		in, out := &in.DefaultBranch, &out.DefaultBranch
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ContentSource)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
 		*out = new(string)
 		**out = **in
 	}
-	if in.EmailsDisabled != nil {
-		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+	if in.ExecuteFilemode != nil {
+		in, out := &in.ExecuteFilemode, &out.ExecuteFilemode
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ExternalAuthorizationClassificationLabel != nil {
-		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+	if in.AuthorEmail != nil {
+		in, out := &in.AuthorEmail, &out.AuthorEmail
 		*out = new(string)
 		**out = **in
 	}
-	if in.ForkingAccessLevel != nil {
-		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.GroupWithProjectTemplatesID != nil {
-		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
-		*out = new(int)
-		**out = **in
-	}
-	if in.ImportURL != nil {
-		in, out := &in.ImportURL, &out.ImportURL
+	if in.AuthorName != nil {
+		in, out := &in.AuthorName, &out.AuthorName
 		*out = new(string)
 		**out = **in
 	}
-	if in.InitializeWithReadme != nil {
-		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
+	if in.LintCIConfig != nil {
+		in, out := &in.LintCIConfig, &out.LintCIConfig
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IssuesAccessLevel != nil {
-		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileParameters.
+func (in *RepositoryFileParameters) DeepCopy() *RepositoryFileParameters {
+	if in == nil {
+		return nil
 	}
-	if in.IssuesTemplate != nil {
-		in, out := &in.IssuesTemplate, &out.IssuesTemplate
-		*out = new(string)
-		**out = **in
+	out := new(RepositoryFileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileSpec) DeepCopyInto(out *RepositoryFileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileSpec.
+func (in *RepositoryFileSpec) DeepCopy() *RepositoryFileSpec {
+	if in == nil {
+		return nil
 	}
-	if in.LFSEnabled != nil {
-		in, out := &in.LFSEnabled, &out.LFSEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(RepositoryFileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFileStatus) DeepCopyInto(out *RepositoryFileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFileStatus.
+func (in *RepositoryFileStatus) DeepCopy() *RepositoryFileStatus {
+	if in == nil {
+		return nil
 	}
-	if in.MergeMethod != nil {
-		in, out := &in.MergeMethod, &out.MergeMethod
-		*out = new(MergeMethodValue)
-		**out = **in
+	out := new(RepositoryFileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Runner) DeepCopyInto(out *Runner) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Runner.
+func (in *Runner) DeepCopy() *Runner {
+	if in == nil {
+		return nil
 	}
-	if in.MergeRequestsAccessLevel != nil {
-		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	out := new(Runner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Runner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MergeRequestsTemplate != nil {
-		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerList) DeepCopyInto(out *RunnerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Runner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerList.
+func (in *RunnerList) DeepCopy() *RunnerList {
+	if in == nil {
+		return nil
 	}
-	if in.MirrorOverwritesDivergedBranches != nil {
-		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
-		*out = new(bool)
-		**out = **in
+	out := new(RunnerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RunnerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MirrorTriggerBuilds != nil {
-		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerObservation) DeepCopyInto(out *RunnerObservation) {
+	*out = *in
+	if in.ContactedAt != nil {
+		in, out := &in.ContactedAt, &out.ContactedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.MirrorUserID != nil {
-		in, out := &in.MirrorUserID, &out.MirrorUserID
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerObservation.
+func (in *RunnerObservation) DeepCopy() *RunnerObservation {
+	if in == nil {
+		return nil
 	}
-	if in.NamespaceID != nil {
-		in, out := &in.NamespaceID, &out.NamespaceID
+	out := new(RunnerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerParameters) DeepCopyInto(out *RunnerParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(int)
 		**out = **in
 	}
-	if in.NamespaceIDRef != nil {
-		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NamespaceIDSelector != nil {
-		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
-		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
-		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OnlyMirrorProtectedBranches != nil {
-		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OperationsAccessLevel != nil {
-		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
-		*out = new(AccessControlValue)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
 		**out = **in
 	}
-	if in.PackagesEnabled != nil {
-		in, out := &in.PackagesEnabled, &out.PackagesEnabled
-		*out = new(bool)
-		**out = **in
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PagesAccessLevel != nil {
-		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrintingMergeRequestLinkEnabled != nil {
-		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PublicBuilds != nil {
-		in, out := &in.PublicBuilds, &out.PublicBuilds
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RemoveSourceBranchAfterMerge != nil {
-		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RepositoryAccessLevel != nil {
-		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.RequestAccessEnabled != nil {
-		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ResolveOutdatedDiffDiscussions != nil {
-		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ServiceDeskEnabled != nil {
-		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SharedRunnersEnabled != nil {
-		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+	if in.RunUntagged != nil {
+		in, out := &in.RunUntagged, &out.RunUntagged
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SnippetsAccessLevel != nil {
-		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.SuggestionCommitMessage != nil {
-		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
-		*out = new(string)
-		**out = **in
-	}
 	if in.TagList != nil {
 		in, out := &in.TagList, &out.TagList
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.TemplateName != nil {
-		in, out := &in.TemplateName, &out.TemplateName
+	if in.AccessLevel != nil {
+		in, out := &in.AccessLevel, &out.AccessLevel
 		*out = new(string)
 		**out = **in
 	}
-	if in.TemplateProjectID != nil {
-		in, out := &in.TemplateProjectID, &out.TemplateProjectID
+	if in.MaximumTimeout != nil {
+		in, out := &in.MaximumTimeout, &out.MaximumTimeout
 		*out = new(int)
 		**out = **in
 	}
-	if in.UseCustomTemplate != nil {
-		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Visibility != nil {
-		in, out := &in.Visibility, &out.Visibility
-		*out = new(VisibilityValue)
-		**out = **in
-	}
-	if in.WikiAccessLevel != nil {
-		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
-func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerParameters.
+func (in *RunnerParameters) DeepCopy() *RunnerParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectParameters)
+	out := new(RunnerParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *RunnerSpec) DeepCopyInto(out *RunnerSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerSpec.
+func (in *RunnerSpec) DeepCopy() *RunnerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(RunnerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+func (in *RunnerStatus) DeepCopyInto(out *RunnerStatus) {
 	*out = *in
-	out.StorageStatistics = in.StorageStatistics
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
-func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerStatus.
+func (in *RunnerStatus) DeepCopy() *RunnerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatistics)
+	out := new(RunnerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
+func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(SharedWithGroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+func (in *SharedWithGroupsParameters) DeepCopyInto(out *SharedWithGroupsParameters) {
 	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
-func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroupsParameters.
+func (in *SharedWithGroupsParameters) DeepCopy() *SharedWithGroupsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedWithGroups)
+	out := new(SharedWithGroupsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1813,6 +3672,149 @@ func (in *StorageStatistics) DeepCopy() *StorageStatistics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagList) DeepCopyInto(out *TagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagList.
+func (in *TagList) DeepCopy() *TagList {
+	if in == nil {
+		return nil
+	}
+	out := new(TagList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagObservation) DeepCopyInto(out *TagObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagObservation.
+func (in *TagObservation) DeepCopy() *TagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagParameters) DeepCopyInto(out *TagParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagParameters.
+func (in *TagParameters) DeepCopy() *TagParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSpec) DeepCopyInto(out *TagSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpec.
+func (in *TagSpec) DeepCopy() *TagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagStatus) DeepCopyInto(out *TagStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagStatus.
+func (in *TagStatus) DeepCopy() *TagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserParameters define the desired state of a Gitlab User
+type UserParameters struct {
+	// The username of the user.
+	Username string `json:"username"`
+
+	// The email address of the user.
+	Email string `json:"email"`
+
+	// Name is the human-readable name of the user.
+	// If set, it overrides metadata.name.
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Set a random password instead of sending a password reset link.
+	// Bot users created for automation usually never log in interactively.
+	// +optional
+	// +immutable
+	ForceRandomPassword *bool `json:"forceRandomPassword,omitempty"`
+
+	// Send the user a password reset link instead of setting a password.
+	// +optional
+	// +immutable
+	ResetPassword *bool `json:"resetPassword,omitempty"`
+
+	// Skip the confirmation of the email address.
+	// +optional
+	// +immutable
+	SkipConfirmation *bool `json:"skipConfirmation,omitempty"`
+
+	// Whether the user is an administrator.
+	// +optional
+	Admin *bool `json:"admin,omitempty"`
+
+	// Whether the user is external.
+	// +optional
+	External *bool `json:"external,omitempty"`
+
+	// Whether the user can create top-level groups.
+	// +optional
+	CanCreateGroup *bool `json:"canCreateGroup,omitempty"`
+
+	// Whether the user's profile is private.
+	// +optional
+	PrivateProfile *bool `json:"privateProfile,omitempty"`
+
+	// Number of projects the user can create.
+	// +optional
+	ProjectsLimit *int `json:"projectsLimit,omitempty"`
+
+	// The biography of the user.
+	// +optional
+	Bio *string `json:"bio,omitempty"`
+
+	// The location of the user.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// The organization of the user.
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+
+	// The job title of the user.
+	// +optional
+	JobTitle *string `json:"jobTitle,omitempty"`
+
+	// The website URL of the user.
+	// +optional
+	WebsiteURL *string `json:"websiteUrl,omitempty"`
+
+	// An admin note for the user.
+	// +optional
+	Note *string `json:"note,omitempty"`
+
+	// The external UID of the user.
+	// +optional
+	ExternUID *string `json:"externUid,omitempty"`
+
+	// The external provider name of the user.
+	// +optional
+	Provider *string `json:"provider,omitempty"`
+}
+
+// UserObservation is the observed state of a User.
+type UserObservation struct {
+	ID          *int         `json:"id,omitempty"`
+	State       *string      `json:"state,omitempty"`
+	WebURL      *string      `json:"webUrl,omitempty"`
+	AvatarURL   *string      `json:"avatarUrl,omitempty"`
+	Bot         *bool        `json:"bot,omitempty"`
+	NamespaceID *int         `json:"namespaceId,omitempty"`
+	CreatedAt   *metav1.Time `json:"createdAt,omitempty"`
+	ConfirmedAt *metav1.Time `json:"confirmedAt,omitempty"`
+}
+
+// A UserSpec defines the desired state of a Gitlab User.
+type UserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a Gitlab User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User is a managed resource that represents a Gitlab User
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Username",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User items
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
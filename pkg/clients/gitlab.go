@@ -18,6 +18,8 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -31,10 +33,13 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
 )
 
+const errAvatarSource = "exactly one of configMapKeyRef and secretKeyRef must be set for the avatar"
+
 // Config provides gitlab configurations for the Gitlab client
 type Config struct {
 	Token   string
@@ -95,6 +100,73 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	}
 }
 
+// GetKeyData returns the data stored at key of obj, which must be a ConfigMap
+// or Secret with its name and namespace set.
+func GetKeyData(ctx context.Context, c client.Client, obj client.Object, key string) ([]byte, error) {
+	if err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj); err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s/%s", obj.GetNamespace(), obj.GetName())
+	}
+
+	var data []byte
+	var ok bool
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		if data, ok = o.BinaryData[key]; !ok {
+			var s string
+			s, ok = o.Data[key]
+			data = []byte(s)
+		}
+	case *corev1.Secret:
+		data, ok = o.Data[key]
+	default:
+		return nil, errors.Errorf("%T is neither a ConfigMap nor a Secret", obj)
+	}
+	if !ok {
+		return nil, errors.Errorf("%s/%s has no key %s", obj.GetNamespace(), obj.GetName(), key)
+	}
+	return data, nil
+}
+
+// KeySource returns the object and key referenced by exactly one of cm and
+// s, or false if not exactly one is set. The object only has its name and
+// namespace set.
+func KeySource(cm *common.ConfigMapKeySelector, s *xpv1.SecretKeySelector) (client.Object, string, bool) {
+	switch {
+	case cm != nil && s == nil:
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cm.Name, Namespace: cm.Namespace}}, cm.Key, true
+	case s != nil && cm == nil:
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace}}, s.Key, true
+	default:
+		return nil, "", false
+	}
+}
+
+// AvatarSource returns the ConfigMap or Secret referenced by a, with only its
+// name and namespace set, and the key holding the image.
+func AvatarSource(a *common.Avatar) (client.Object, string, error) {
+	obj, key, ok := KeySource(a.ConfigMapKeyRef, a.SecretKeyRef)
+	if !ok {
+		return nil, "", errors.New(errAvatarSource)
+	}
+	return obj, key, nil
+}
+
+// GetAvatar reads the avatar image stored at key of obj, which must be a
+// ConfigMap or Secret with its name and namespace set, and returns it along
+// with the hex encoded SHA-256 hash of its content.
+func GetAvatar(ctx context.Context, c client.Client, obj client.Object, key string) ([]byte, string, error) {
+	img, err := GetKeyData(ctx, c, obj, key)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(img) == 0 {
+		return nil, "", errors.Errorf("avatar at key %s of %s/%s is empty", key, obj.GetNamespace(), obj.GetName())
+	}
+
+	sum := sha256.Sum256(img)
+	return img, hex.EncodeToString(sum[:]), nil
+}
+
 // LateInitializeStringPtr returns `from` if `in` is nil and `from` is non-empty,
 // in other cases it returns `in`.
 func LateInitializeStringPtr(in *string, from string) *string {
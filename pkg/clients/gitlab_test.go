@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+func TestAvatarSource(t *testing.T) {
+	type want struct {
+		obj client.Object
+		key string
+		err error
+	}
+	cases := map[string]struct {
+		avatar *common.Avatar
+		want   want
+	}{
+		"ConfigMap": {
+			avatar: &common.Avatar{
+				ConfigMapKeyRef: &common.ConfigMapKeySelector{Name: "brand", Namespace: "default", Key: "avatar.png"},
+			},
+			want: want{
+				obj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "brand", Namespace: "default"}},
+				key: "avatar.png",
+			},
+		},
+		"Secret": {
+			avatar: &common.Avatar{
+				SecretKeyRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "brand", Namespace: "default"},
+					Key:             "avatar.png",
+				},
+			},
+			want: want{
+				obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "brand", Namespace: "default"}},
+				key: "avatar.png",
+			},
+		},
+		"NoSource": {
+			avatar: &common.Avatar{},
+			want: want{
+				err: errors.New(errAvatarSource),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj, key, err := AvatarSource(tc.avatar)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,8 @@ type MockClient struct {
 	MockDeleteGroup           func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockShareGroupWithGroup   func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	MockUnshareGroupFromGroup func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockTransferSubGroup      func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	MockRestoreGroup          func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 
 	MockGetMember    func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockAddMember    func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
@@ -70,7 +72,7 @@ func (c *MockClient) UpdateGroup(pid interface{}, opt *gitlab.UpdateGroupOptions
 
 // DeleteGroup calls the underlying MockDeleteGroup method
 func (c *MockClient) DeleteGroup(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-	return c.MockDeleteGroup(pid)
+	return c.MockDeleteGroup(pid, options...)
 }
 
 // ShareGroupWithGroup calls the underlying MockShareGroupWithGroup method
@@ -83,6 +85,16 @@ func (c *MockClient) UnshareGroupFromGroup(gid interface{}, groupID int, options
 	return c.MockUnshareGroupFromGroup(gid, groupID, options...)
 }
 
+// TransferSubGroup calls the underlying MockTransferSubGroup method
+func (c *MockClient) TransferSubGroup(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.MockTransferSubGroup(gid, opt, options...)
+}
+
+// RestoreGroup calls the underlying MockRestoreGroup method
+func (c *MockClient) RestoreGroup(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.MockRestoreGroup(gid, options...)
+}
+
 // GetGroupMember calls the underlying MockGetMember method.
 func (c *MockClient) GetGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
 	return c.MockGetMember(gid, user)
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -39,6 +40,8 @@ type Client interface {
 	DeleteGroup(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	ShareGroupWithGroup(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	UnshareGroupFromGroup(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	TransferSubGroup(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+	RestoreGroup(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 }
 
 // NewGroupClient returns a new Gitlab Group service
@@ -55,6 +58,19 @@ func IsErrorGroupNotFound(err error) bool {
 	return strings.Contains(err.Error(), errGroupNotFound)
 }
 
+// WithPermanentlyRemove returns a request option that asks GitLab to
+// immediately remove a group that is already marked for deletion. fullPath
+// must be the full path of the group.
+func WithPermanentlyRemove(fullPath string) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("permanently_remove", "true")
+		q.Set("full_path", fullPath)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
 // VisibilityValueV1alpha1ToGitlab converts *v1alpha1.VisibilityValue to *gitlab.VisibilityValue
 func VisibilityValueV1alpha1ToGitlab(from *v1alpha1.VisibilityValue) *gitlab.VisibilityValue {
 	return (*gitlab.VisibilityValue)(from)
@@ -82,6 +98,7 @@ func GenerateObservation(grp *gitlab.Group) v1alpha1.GroupObservation { // nolin
 		WebURL:    &grp.WebURL,
 		FullName:  &grp.FullName,
 		FullPath:  &grp.FullPath,
+		ParentID:  &grp.ParentID,
 		LDAPCN:    &grp.LDAPCN,
 	}
 
@@ -17,10 +17,13 @@ limitations under the License.
 package groups
 
 import (
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -153,6 +156,7 @@ func TestGenerateObservation(t *testing.T) {
 				WebURL:     &webURL,
 				FullName:   &fullName,
 				FullPath:   &fullPath,
+				ParentID:   &parentIDint,
 				Statistics: &v1alpha1Statistics,
 				CustomAttributes: []v1alpha1.CustomAttribute{
 					{
@@ -204,6 +208,7 @@ func TestGenerateObservation(t *testing.T) {
 				WebURL:    &s,
 				FullName:  &s,
 				FullPath:  &s,
+				ParentID:  &i,
 				LDAPCN:    &s,
 
 				SharedWithGroups: []v1alpha1.SharedWithGroupsObservation{{
@@ -393,3 +398,18 @@ func TestGenerateEditGroupOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestWithPermanentlyRemove(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodDelete, "https://gitlab.example.com/api/v4/groups/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WithPermanentlyRemove(path)(req); err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{"permanently_remove": {"true"}, "full_path": {path}}
+	if diff := cmp.Diff(want, req.URL.Query()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
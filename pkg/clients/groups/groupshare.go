@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+// GenerateGroupShareObservation is used to produce v1alpha1.GroupShareObservation
+// from the entry of grp.SharedWithGroups matching sharedWithGroupID. The
+// returned bool reports whether such an entry exists.
+func GenerateGroupShareObservation(grp *gitlab.Group, sharedWithGroupID int) (v1alpha1.GroupShareObservation, bool) {
+	if grp == nil {
+		return v1alpha1.GroupShareObservation{}, false
+	}
+	for _, sh := range grp.SharedWithGroups {
+		if sh.GroupID != sharedWithGroupID {
+			continue
+		}
+		o := v1alpha1.GroupShareObservation{
+			GroupName:        sh.GroupName,
+			GroupFullPath:    sh.GroupFullPath,
+			GroupAccessLevel: sh.GroupAccessLevel,
+		}
+		if sh.ExpiresAt != nil {
+			o.ExpiresAt = &metav1.Time{Time: time.Time(*sh.ExpiresAt)}
+		}
+		return o, true
+	}
+	return v1alpha1.GroupShareObservation{}, false
+}
+
+// GenerateShareGroupWithGroupOptions generates group share options
+func GenerateShareGroupWithGroupOptions(p *v1alpha1.GroupShareParameters) *gitlab.ShareGroupWithGroupOptions {
+	opt := &gitlab.ShareGroupWithGroupOptions{
+		GroupID:     p.SharedWithGroupID,
+		GroupAccess: accessLevelValueV1alpha1ToGitlab(&p.GroupAccessLevel),
+	}
+	if p.ExpiresAt != nil {
+		opt.ExpiresAt = (*gitlab.ISOTime)(&p.ExpiresAt.Time)
+	}
+	return opt
+}
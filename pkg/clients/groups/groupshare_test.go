@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestGenerateShareGroupWithGroupOptions(t *testing.T) {
+	sharedWithGroupID := 5678
+	expires := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		parameters *v1alpha1.GroupShareParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *gitlab.ShareGroupWithGroupOptions
+	}{
+		"AllFields": {
+			args: args{
+				parameters: &v1alpha1.GroupShareParameters{
+					GroupID:           &groupID,
+					SharedWithGroupID: &sharedWithGroupID,
+					GroupAccessLevel:  v1alpha1AccessLevelValue,
+					ExpiresAt:         &metav1.Time{Time: expires},
+				},
+			},
+			want: &gitlab.ShareGroupWithGroupOptions{
+				GroupID:     &sharedWithGroupID,
+				GroupAccess: &gitlabAccessLevelValue,
+				ExpiresAt:   (*gitlab.ISOTime)(&expires),
+			},
+		},
+		"SomeFields": {
+			args: args{
+				parameters: &v1alpha1.GroupShareParameters{
+					GroupID:           &groupID,
+					SharedWithGroupID: &sharedWithGroupID,
+					GroupAccessLevel:  v1alpha1AccessLevelValue,
+				},
+			},
+			want: &gitlab.ShareGroupWithGroupOptions{
+				GroupID:     &sharedWithGroupID,
+				GroupAccess: &gitlabAccessLevelValue,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateShareGroupWithGroupOptions(tc.args.parameters)
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b gitlab.ISOTime) bool {
+				return time.Time(a).Equal(time.Time(b))
+			})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateBranchObservation(t *testing.T) {
+	sha := "0b4bc9a49b562e85de7cc9e834518ea6828729b9"
+
+	cases := map[string]struct {
+		branch *gitlab.Branch
+		want   v1alpha1.BranchObservation
+	}{
+		"Nil": {
+			want: v1alpha1.BranchObservation{},
+		},
+		"NoCommit": {
+			branch: &gitlab.Branch{Name: "main", Default: true},
+			want:   v1alpha1.BranchObservation{Default: true},
+		},
+		"AllFields": {
+			branch: &gitlab.Branch{
+				Name:      "release/1.0",
+				Commit:    &gitlab.Commit{ID: sha},
+				Protected: true,
+				Merged:    true,
+				WebURL:    "https://gitlab.example.com/group/project/-/tree/release/1.0",
+			},
+			want: v1alpha1.BranchObservation{
+				CommitSHA: sha,
+				Protected: true,
+				Merged:    true,
+				WebURL:    "https://gitlab.example.com/group/project/-/tree/release/1.0",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBranchObservation(tc.branch)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// AgentTokenStatusRevoked is the status of a revoked agent token.
+const AgentTokenStatusRevoked = "revoked"
+
+// ClusterAgentClient defines Gitlab cluster agent service operations
+type ClusterAgentClient interface {
+	GetAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	RegisterAgent(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	DeleteAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// ClusterAgentTokenClient defines Gitlab cluster agent token service operations
+type ClusterAgentTokenClient interface {
+	GetAgentToken(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error)
+	CreateAgentToken(pid interface{}, aid int, opt *gitlab.CreateAgentTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error)
+	RevokeAgentToken(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewClusterAgentClient returns a new Gitlab cluster agent service
+func NewClusterAgentClient(cfg clients.Config) ClusterAgentClient {
+	git := clients.NewClient(cfg)
+	return git.ClusterAgents
+}
+
+// NewClusterAgentTokenClient returns a new Gitlab cluster agent token service
+func NewClusterAgentTokenClient(cfg clients.Config) ClusterAgentTokenClient {
+	git := clients.NewClient(cfg)
+	return git.ClusterAgents
+}
+
+// GenerateClusterAgentObservation is used to produce
+// v1alpha1.ClusterAgentObservation from gitlab.Agent.
+func GenerateClusterAgentObservation(a *gitlab.Agent) v1alpha1.ClusterAgentObservation {
+	if a == nil {
+		return v1alpha1.ClusterAgentObservation{}
+	}
+	return v1alpha1.ClusterAgentObservation{
+		CreatedAt:                      clients.TimeToMetaTime(a.CreatedAt),
+		CreatedByUserID:                a.CreatedByUserID,
+		ConfigProjectPathWithNamespace: a.ConfigProject.PathWithNamespace,
+	}
+}
+
+// GenerateRegisterAgentOptions generates cluster agent registration options
+func GenerateRegisterAgentOptions(p *v1alpha1.ClusterAgentParameters) *gitlab.RegisterAgentOptions {
+	return &gitlab.RegisterAgentOptions{
+		Name: &p.Name,
+	}
+}
+
+// GenerateClusterAgentTokenObservation is used to produce
+// v1alpha1.ClusterAgentTokenObservation from gitlab.AgentToken.
+func GenerateClusterAgentTokenObservation(t *gitlab.AgentToken) v1alpha1.ClusterAgentTokenObservation {
+	if t == nil {
+		return v1alpha1.ClusterAgentTokenObservation{}
+	}
+	return v1alpha1.ClusterAgentTokenObservation{
+		Status:          t.Status,
+		CreatedAt:       clients.TimeToMetaTime(t.CreatedAt),
+		CreatedByUserID: t.CreatedByUserID,
+		LastUsedAt:      clients.TimeToMetaTime(t.LastUsedAt),
+	}
+}
+
+// GenerateCreateAgentTokenOptions generates cluster agent token creation
+// options
+func GenerateCreateAgentTokenOptions(p *v1alpha1.ClusterAgentTokenParameters) *gitlab.CreateAgentTokenOptions {
+	return &gitlab.CreateAgentTokenOptions{
+		Name:        &p.Name,
+		Description: p.Description,
+	}
+}
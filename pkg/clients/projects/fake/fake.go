@@ -17,6 +17,8 @@ limitations under the License.
 package fake
 
 import (
+	"io"
+
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
@@ -28,10 +30,24 @@ var _ projects.Client = &MockClient{}
 type MockClient struct {
 	projects.Client
 
-	MockGetProject    func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockCreateProject func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockEditProject   func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockDeleteProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetProject       func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockCreateProject    func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockEditProject      func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockDeleteProject    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockArchiveProject   func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockUnarchiveProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockForkProject      func(pid interface{}, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockTransferProject  func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+
+	MockGetNamespace func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error)
+
+	MockShareProjectWithGroup        func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteSharedProjectFromGroup func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockScheduleExport func(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockExportStatus   func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error)
+	MockDownloadExport func(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockImportFromFile func(archive io.Reader, opt *gitlab.ImportFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error)
 
 	MockGetHook    func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockAddHook    func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
@@ -62,15 +78,70 @@ type MockClient struct {
 	MockUpdateDeployKey func(pid interface{}, deployKey int, opt *gitlab.UpdateDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 	MockGetDeployKey    func(pid interface{}, deployKey int, options ...*gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
 
-	MockGetPipelineSchedule            func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
-	MockCreatePipelineSchedule         func(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
-	MockEditPipelineSchedule           func(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
-	MockDeletePipelineSchedule         func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
-	MockCreatePipelineScheduleVariable func(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
-	MockEditPipelineScheduleVariable   func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
-	MockDeletePipelineScheduleVariable func(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
+	MockGetPipelineSchedule             func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+	MockCreatePipelineSchedule          func(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+	MockEditPipelineSchedule            func(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+	MockDeletePipelineSchedule          func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockTakeOwnershipOfPipelineSchedule func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
+	MockCreatePipelineScheduleVariable  func(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
+	MockEditPipelineScheduleVariable    func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
+	MockDeletePipelineScheduleVariable  func(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
+
+	MockGetFile    func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error)
+	MockCreateFile func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	MockUpdateFile func(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	MockDeleteFile func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockProjectNamespaceLint func(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error)
+
+	MockGetBranch    func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockCreateBranch func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockDeleteBranch func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetTag    func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	MockCreateTag func(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	MockDeleteTag func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetRelease    func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.Release, *gitlab.Response, error)
+	MockCreateRelease func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockUpdateRelease func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	MockDeleteRelease func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+
+	MockListReleaseLinks  func(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error)
+	MockCreateReleaseLink func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	MockUpdateReleaseLink func(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	MockDeleteReleaseLink func(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+
+	MockGetPipelineTrigger    func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	MockAddPipelineTrigger    func(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	MockEditPipelineTrigger   func(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	MockDeletePipelineTrigger func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetPipeline    func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error)
+	MockCreatePipeline func(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error)
+	MockDeletePipeline func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockListPipelineJobs func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error)
+
+	MockCreateUserRunner    func(opt *projects.CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*projects.UserRunner, *gitlab.Response, error)
+	MockGetRunnerDetails    func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	MockUpdateRunnerDetails func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	MockRemoveRunner        func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockResetRunnerAuthenticationToken func(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error)
+
+	MockEnableProjectRunner  func(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error)
+	MockDisableProjectRunner func(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetAgent      func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	MockRegisterAgent func(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error)
+	MockDeleteAgent   func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetAgentToken    func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error)
+	MockCreateAgentToken func(pid interface{}, aid int, opt *gitlab.CreateAgentTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error)
+	MockRevokeAgentToken func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
 // GetPipelineSchedule calls the underlying MockGetPipelineSchedule method.
@@ -93,6 +164,11 @@ func (c *MockClient) DeletePipelineSchedule(pid interface{}, schedule int, optio
 	return c.MockDeletePipelineSchedule(pid, schedule)
 }
 
+// TakeOwnershipOfPipelineSchedule calls the underlying MockTakeOwnershipOfPipelineSchedule method.
+func (c *MockClient) TakeOwnershipOfPipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+	return c.MockTakeOwnershipOfPipelineSchedule(pid, schedule)
+}
+
 // CreatePipelineScheduleVariable calls the underlying MockCreatePipelineScheduleVariable method.
 func (c *MockClient) CreatePipelineScheduleVariable(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
 	return c.MockCreatePipelineScheduleVariable(pid, schedule, opt)
@@ -125,7 +201,62 @@ func (c *MockClient) EditProject(pid interface{}, opt *gitlab.EditProjectOptions
 
 // DeleteProject calls the underlying MockDeleteProject method
 func (c *MockClient) DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-	return c.MockDeleteProject(pid)
+	return c.MockDeleteProject(pid, options...)
+}
+
+// ArchiveProject calls the underlying MockArchiveProject method
+func (c *MockClient) ArchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockArchiveProject(pid)
+}
+
+// UnarchiveProject calls the underlying MockUnarchiveProject method
+func (c *MockClient) UnarchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockUnarchiveProject(pid)
+}
+
+// ForkProject calls the underlying MockForkProject method
+func (c *MockClient) ForkProject(pid interface{}, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockForkProject(pid, opt, options...)
+}
+
+// TransferProject calls the underlying MockTransferProject method
+func (c *MockClient) TransferProject(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockTransferProject(pid, opt)
+}
+
+// GetNamespace calls the underlying MockGetNamespace method
+func (c *MockClient) GetNamespace(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+	return c.MockGetNamespace(id, options...)
+}
+
+// ScheduleExport calls the underlying MockScheduleExport method
+func (c *MockClient) ScheduleExport(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockScheduleExport(pid, opt, options...)
+}
+
+// ExportStatus calls the underlying MockExportStatus method
+func (c *MockClient) ExportStatus(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+	return c.MockExportStatus(pid, options...)
+}
+
+// DownloadExport calls the underlying MockDownloadExport method
+func (c *MockClient) DownloadExport(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDownloadExport(pid, w, options...)
+}
+
+// ImportFromFile calls the underlying MockImportFromFile method
+func (c *MockClient) ImportFromFile(archive io.Reader, opt *gitlab.ImportFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error) {
+	return c.MockImportFromFile(archive, opt, options...)
+}
+
+// ShareProjectWithGroup calls the underlying MockShareProjectWithGroup method
+func (c *MockClient) ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockShareProjectWithGroup(pid, opt)
+}
+
+// DeleteSharedProjectFromGroup calls the underlying MockDeleteSharedProjectFromGroup method
+func (c *MockClient) DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteSharedProjectFromGroup(pid, groupID)
 }
 
 // GetProjectHook calls the underlying MockGetProjectHook method.
@@ -252,3 +383,204 @@ func (c *MockClient) RevokeProjectAccessToken(pid interface{}, id int, options .
 func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
 	return c.MockListUsers(opt)
 }
+
+// GetFile calls the underlying MockGetFile method.
+func (c *MockClient) GetFile(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return c.MockGetFile(pid, fileName, opt)
+}
+
+// CreateFile calls the underlying MockCreateFile method.
+func (c *MockClient) CreateFile(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return c.MockCreateFile(pid, fileName, opt)
+}
+
+// UpdateFile calls the underlying MockUpdateFile method.
+func (c *MockClient) UpdateFile(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+	return c.MockUpdateFile(pid, fileName, opt)
+}
+
+// DeleteFile calls the underlying MockDeleteFile method.
+func (c *MockClient) DeleteFile(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteFile(pid, fileName, opt)
+}
+
+// ProjectNamespaceLint calls the underlying MockProjectNamespaceLint method.
+func (c *MockClient) ProjectNamespaceLint(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error) {
+	return c.MockProjectNamespaceLint(pid, opt)
+}
+
+// GetBranch calls the underlying MockGetBranch method.
+func (c *MockClient) GetBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockGetBranch(pid, branch)
+}
+
+// CreateBranch calls the underlying MockCreateBranch method.
+func (c *MockClient) CreateBranch(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockCreateBranch(pid, opt)
+}
+
+// DeleteBranch calls the underlying MockDeleteBranch method.
+func (c *MockClient) DeleteBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteBranch(pid, branch)
+}
+
+// GetTag calls the underlying MockGetTag method.
+func (c *MockClient) GetTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return c.MockGetTag(pid, tag)
+}
+
+// CreateTag calls the underlying MockCreateTag method.
+func (c *MockClient) CreateTag(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error) {
+	return c.MockCreateTag(pid, opt)
+}
+
+// DeleteTag calls the underlying MockDeleteTag method.
+func (c *MockClient) DeleteTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteTag(pid, tag)
+}
+
+// GetRelease calls the underlying MockGetRelease method.
+func (c *MockClient) GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.Release, *gitlab.Response, error) {
+	return c.MockGetRelease(pid, tagName)
+}
+
+// CreateRelease calls the underlying MockCreateRelease method.
+func (c *MockClient) CreateRelease(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockCreateRelease(pid, opts)
+}
+
+// UpdateRelease calls the underlying MockUpdateRelease method.
+func (c *MockClient) UpdateRelease(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockUpdateRelease(pid, tagName, opts)
+}
+
+// DeleteRelease calls the underlying MockDeleteRelease method.
+func (c *MockClient) DeleteRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+	return c.MockDeleteRelease(pid, tagName)
+}
+
+// ListReleaseLinks calls the underlying MockListReleaseLinks method.
+func (c *MockClient) ListReleaseLinks(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockListReleaseLinks(pid, tagName, opt)
+}
+
+// CreateReleaseLink calls the underlying MockCreateReleaseLink method.
+func (c *MockClient) CreateReleaseLink(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockCreateReleaseLink(pid, tagName, opt)
+}
+
+// UpdateReleaseLink calls the underlying MockUpdateReleaseLink method.
+func (c *MockClient) UpdateReleaseLink(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockUpdateReleaseLink(pid, tagName, link, opt)
+}
+
+// DeleteReleaseLink calls the underlying MockDeleteReleaseLink method.
+func (c *MockClient) DeleteReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return c.MockDeleteReleaseLink(pid, tagName, link)
+}
+
+// GetPipelineTrigger calls the underlying MockGetPipelineTrigger method.
+func (c *MockClient) GetPipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return c.MockGetPipelineTrigger(pid, trigger)
+}
+
+// AddPipelineTrigger calls the underlying MockAddPipelineTrigger method.
+func (c *MockClient) AddPipelineTrigger(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return c.MockAddPipelineTrigger(pid, opt)
+}
+
+// EditPipelineTrigger calls the underlying MockEditPipelineTrigger method.
+func (c *MockClient) EditPipelineTrigger(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+	return c.MockEditPipelineTrigger(pid, trigger, opt)
+}
+
+// DeletePipelineTrigger calls the underlying MockDeletePipelineTrigger method.
+func (c *MockClient) DeletePipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeletePipelineTrigger(pid, trigger)
+}
+
+// GetPipeline calls the underlying MockGetPipeline method.
+func (c *MockClient) GetPipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return c.MockGetPipeline(pid, pipeline)
+}
+
+// CreatePipeline calls the underlying MockCreatePipeline method.
+func (c *MockClient) CreatePipeline(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return c.MockCreatePipeline(pid, opt)
+}
+
+// DeletePipeline calls the underlying MockDeletePipeline method.
+func (c *MockClient) DeletePipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeletePipeline(pid, pipeline)
+}
+
+// ListPipelineJobs calls the underlying MockListPipelineJobs method.
+func (c *MockClient) ListPipelineJobs(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+	return c.MockListPipelineJobs(pid, pipelineID, opts)
+}
+
+// CreateUserRunner calls the underlying MockCreateUserRunner method.
+func (c *MockClient) CreateUserRunner(opt *projects.CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*projects.UserRunner, *gitlab.Response, error) {
+	return c.MockCreateUserRunner(opt)
+}
+
+// GetRunnerDetails calls the underlying MockGetRunnerDetails method.
+func (c *MockClient) GetRunnerDetails(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+	return c.MockGetRunnerDetails(rid)
+}
+
+// UpdateRunnerDetails calls the underlying MockUpdateRunnerDetails method.
+func (c *MockClient) UpdateRunnerDetails(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+	return c.MockUpdateRunnerDetails(rid, opt)
+}
+
+// RemoveRunner calls the underlying MockRemoveRunner method.
+func (c *MockClient) RemoveRunner(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRemoveRunner(rid)
+}
+
+// ResetRunnerAuthenticationToken calls the underlying
+// MockResetRunnerAuthenticationToken method.
+func (c *MockClient) ResetRunnerAuthenticationToken(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error) {
+	return c.MockResetRunnerAuthenticationToken(rid)
+}
+
+// EnableProjectRunner calls the underlying MockEnableProjectRunner method.
+func (c *MockClient) EnableProjectRunner(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error) {
+	return c.MockEnableProjectRunner(pid, opt)
+}
+
+// DisableProjectRunner calls the underlying MockDisableProjectRunner method.
+func (c *MockClient) DisableProjectRunner(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDisableProjectRunner(pid, runner)
+}
+
+// GetAgent calls the underlying MockGetAgent method.
+func (c *MockClient) GetAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+	return c.MockGetAgent(pid, id)
+}
+
+// RegisterAgent calls the underlying MockRegisterAgent method.
+func (c *MockClient) RegisterAgent(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+	return c.MockRegisterAgent(pid, opt)
+}
+
+// DeleteAgent calls the underlying MockDeleteAgent method.
+func (c *MockClient) DeleteAgent(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteAgent(pid, id)
+}
+
+// GetAgentToken calls the underlying MockGetAgentToken method.
+func (c *MockClient) GetAgentToken(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+	return c.MockGetAgentToken(pid, aid, id)
+}
+
+// CreateAgentToken calls the underlying MockCreateAgentToken method.
+func (c *MockClient) CreateAgentToken(pid interface{}, aid int, opt *gitlab.CreateAgentTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+	return c.MockCreateAgentToken(pid, aid, opt)
+}
+
+// RevokeAgentToken calls the underlying MockRevokeAgentToken method.
+func (c *MockClient) RevokeAgentToken(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRevokeAgentToken(pid, aid, id)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ImportExportClient defines Gitlab Project import/export service operations
+type ImportExportClient interface {
+	ScheduleExport(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ExportStatus(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error)
+	DownloadExport(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ImportFromFile(archive io.Reader, opt *gitlab.ImportFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error)
+}
+
+type importExportClient struct {
+	*gitlab.ProjectImportExportService
+	git *gitlab.Client
+}
+
+// DownloadExport writes the archive of a finished export to w as it is
+// received, rather than reading it into memory like ExportDownload does.
+func (c *importExportClient) DownloadExport(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	u := fmt.Sprintf("projects/%s/export/download", gitlab.PathEscape(fmt.Sprint(pid)))
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.Do(req, w)
+}
+
+// NewImportExportClient returns a new Gitlab Project import/export service
+func NewImportExportClient(cfg clients.Config) ImportExportClient {
+	git := clients.NewClient(cfg)
+	return &importExportClient{ProjectImportExportService: git.ProjectImportExport, git: git}
+}
+
+// GenerateScheduleExportOptions generates export options that make GitLab
+// upload the finished archive to uploadURL. The archive is kept for download
+// if uploadURL is empty.
+func GenerateScheduleExportOptions(p *v1alpha1.ProjectExportParameters, uploadURL string) *gitlab.ScheduleExportOptions {
+	opt := &gitlab.ScheduleExportOptions{
+		Description: p.Description,
+	}
+	if uploadURL == "" {
+		return opt
+	}
+	method := "PUT"
+	if p.UploadHTTPMethod != nil {
+		method = *p.UploadHTTPMethod
+	}
+	opt.Upload.URL = &uploadURL
+	opt.Upload.HTTPMethod = &method
+	return opt
+}
+
+// GenerateProjectExportObservation is used to produce
+// v1alpha1.ProjectExportObservation from gitlab.ExportStatus.
+func GenerateProjectExportObservation(st *gitlab.ExportStatus) v1alpha1.ProjectExportObservation {
+	if st == nil {
+		return v1alpha1.ProjectExportObservation{}
+	}
+	return v1alpha1.ProjectExportObservation{
+		ExportStatus: st.ExportStatus,
+		Message:      st.Message,
+		CreatedAt:    clients.TimeToMetaTime(st.CreatedAt),
+	}
+}
+
+// GenerateImportFileOptions generates options to import a project from an
+// archive.
+func GenerateImportFileOptions(name string, p *v1alpha1.ProjectParameters) *gitlab.ImportFileOptions {
+	// Name field overrides resource name
+	if p.Name != nil {
+		name = *p.Name
+	}
+	opt := &gitlab.ImportFileOptions{
+		Name: &name,
+		Path: p.Path,
+	}
+	if p.NamespaceID != nil {
+		opt.Namespace = gitlab.String(strconv.Itoa(*p.NamespaceID))
+	}
+	if p.ImportArchive != nil {
+		opt.Overwrite = p.ImportArchive.Overwrite
+	}
+	return opt
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func TestDownloadExport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/1234/export/download" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "archive")
+	}))
+	defer srv.Close()
+
+	c := NewImportExportClient(clients.Config{BaseURL: srv.URL})
+	var got bytes.Buffer
+	if _, err := c.DownloadExport("1234", &got); err != nil {
+		t.Fatalf("DownloadExport(...): %v", err)
+	}
+	if diff := cmp.Diff("archive", got.String()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateScheduleExportOptions(t *testing.T) {
+	uploadURL := "https://bucket.example.com/export.tar.gz?signature=abc"
+	put := "PUT"
+	post := "POST"
+	exportDescription := "exported"
+
+	withUpload := func(opt *gitlab.ScheduleExportOptions, method *string) *gitlab.ScheduleExportOptions {
+		opt.Upload.URL = &uploadURL
+		opt.Upload.HTTPMethod = method
+		return opt
+	}
+
+	cases := map[string]struct {
+		parameters *v1alpha1.ProjectExportParameters
+		uploadURL  string
+		want       *gitlab.ScheduleExportOptions
+	}{
+		"DefaultMethod": {
+			parameters: &v1alpha1.ProjectExportParameters{},
+			uploadURL:  uploadURL,
+			want:       withUpload(&gitlab.ScheduleExportOptions{}, &put),
+		},
+		"AllFields": {
+			parameters: &v1alpha1.ProjectExportParameters{
+				Description:      &exportDescription,
+				UploadHTTPMethod: &post,
+			},
+			uploadURL: uploadURL,
+			want:      withUpload(&gitlab.ScheduleExportOptions{Description: &exportDescription}, &post),
+		},
+		"Download": {
+			parameters: &v1alpha1.ProjectExportParameters{
+				Description:      &exportDescription,
+				UploadHTTPMethod: &post,
+			},
+			want: &gitlab.ScheduleExportOptions{Description: &exportDescription},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateScheduleExportOptions(tc.parameters, tc.uploadURL)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProjectExportObservation(t *testing.T) {
+	createdAt := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		status *gitlab.ExportStatus
+		want   v1alpha1.ProjectExportObservation
+	}{
+		"Nil": {
+			want: v1alpha1.ProjectExportObservation{},
+		},
+		"AllFields": {
+			status: &gitlab.ExportStatus{
+				ExportStatus: "finished",
+				Message:      "done",
+				CreatedAt:    &createdAt,
+			},
+			want: v1alpha1.ProjectExportObservation{
+				ExportStatus: "finished",
+				Message:      "done",
+				CreatedAt:    &metav1.Time{Time: createdAt},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProjectExportObservation(tc.status)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateImportFileOptions(t *testing.T) {
+	overwrite := true
+	namespace := strconv.Itoa(namespaceID)
+
+	cases := map[string]struct {
+		name       string
+		parameters *v1alpha1.ProjectParameters
+		want       *gitlab.ImportFileOptions
+	}{
+		"AllFields": {
+			name: name,
+			parameters: &v1alpha1.ProjectParameters{
+				Path:          &path,
+				NamespaceID:   &namespaceID,
+				ImportArchive: &v1alpha1.ImportArchive{Overwrite: &overwrite},
+			},
+			want: &gitlab.ImportFileOptions{
+				Name:      &name,
+				Path:      &path,
+				Namespace: &namespace,
+				Overwrite: &overwrite,
+			},
+		},
+		"NameOverride": {
+			name: name,
+			parameters: &v1alpha1.ProjectParameters{
+				Name: &overrideName,
+			},
+			want: &gitlab.ImportFileOptions{
+				Name: &overrideName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImportFileOptions(tc.name, tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// PipelineStatusSuccess is the status of a pipeline that passed.
+const PipelineStatusSuccess = "success"
+
+// PipelineClient defines Gitlab pipeline service operations
+type PipelineClient interface {
+	GetPipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error)
+	CreatePipeline(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error)
+	DeletePipeline(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// PipelineJobClient defines Gitlab job service operations on pipelines
+type PipelineJobClient interface {
+	ListPipelineJobs(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error)
+}
+
+// NewPipelineClient returns a new Gitlab pipeline service
+func NewPipelineClient(cfg clients.Config) PipelineClient {
+	git := clients.NewClient(cfg)
+	return git.Pipelines
+}
+
+// NewPipelineJobClient returns a new Gitlab job service
+func NewPipelineJobClient(cfg clients.Config) PipelineJobClient {
+	git := clients.NewClient(cfg)
+	return git.Jobs
+}
+
+// GeneratePipelineObservation is used to produce v1alpha1.PipelineObservation
+// from gitlab.Pipeline and its jobs.
+func GeneratePipelineObservation(p *gitlab.Pipeline, jobs []*gitlab.Job) v1alpha1.PipelineObservation {
+	if p == nil {
+		return v1alpha1.PipelineObservation{}
+	}
+	o := v1alpha1.PipelineObservation{
+		ID:         p.ID,
+		Status:     p.Status,
+		SHA:        p.SHA,
+		WebURL:     p.WebURL,
+		StartedAt:  clients.TimeToMetaTime(p.StartedAt),
+		FinishedAt: clients.TimeToMetaTime(p.FinishedAt),
+	}
+	for _, j := range jobs {
+		o.Jobs = append(o.Jobs, v1alpha1.PipelineJob{
+			ID:     j.ID,
+			Name:   j.Name,
+			Stage:  j.Stage,
+			Status: j.Status,
+		})
+	}
+	return o
+}
+
+// GenerateCreatePipelineOptions generates pipeline creation options
+func GenerateCreatePipelineOptions(p *v1alpha1.PipelineParameters) *gitlab.CreatePipelineOptions {
+	opt := &gitlab.CreatePipelineOptions{
+		Ref: &p.Ref,
+	}
+	if len(p.Variables) > 0 {
+		vars := make([]*gitlab.PipelineVariableOptions, len(p.Variables))
+		for i := range p.Variables {
+			v := &p.Variables[i]
+			vars[i] = &gitlab.PipelineVariableOptions{
+				Key:          &v.Key,
+				Value:        &v.Value,
+				VariableType: v.VariableType,
+			}
+		}
+		opt.Variables = &vars
+	}
+	return opt
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateCreatePipelineOptions(t *testing.T) {
+	ref := "main"
+	key := "IMAGE"
+	value := "registry.example.com/app"
+	fileType := "file"
+
+	cases := map[string]struct {
+		parameters *v1alpha1.PipelineParameters
+		want       *gitlab.CreatePipelineOptions
+	}{
+		"NoVariables": {
+			parameters: &v1alpha1.PipelineParameters{Ref: ref},
+			want:       &gitlab.CreatePipelineOptions{Ref: &ref},
+		},
+		"Variables": {
+			parameters: &v1alpha1.PipelineParameters{
+				Ref:       ref,
+				Variables: []v1alpha1.PipelineVariable{{Key: key, Value: value, VariableType: &fileType}},
+			},
+			want: &gitlab.CreatePipelineOptions{
+				Ref:       &ref,
+				Variables: &[]*gitlab.PipelineVariableOptions{{Key: &key, Value: &value, VariableType: &fileType}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreatePipelineOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -16,7 +16,23 @@ limitations under the License.
 
 package projects
 
-import "github.com/xanzy/go-gitlab"
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errResolvePipelineVariable = "cannot resolve value of pipeline variable %s"
+	errPipelineVariableValue   = "pipeline variable %s must not set both value and valueSecretRef"
+)
 
 // PipelineScheduleClient is an interface for Gitlab PipelineScheduleService.
 type PipelineScheduleClient interface {
@@ -24,8 +40,36 @@ type PipelineScheduleClient interface {
 	CreatePipelineSchedule(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
 	EditPipelineSchedule(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
 	DeletePipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	TakeOwnershipOfPipelineSchedule(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error)
 
 	CreatePipelineScheduleVariable(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	DeletePipelineScheduleVariable(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	EditPipelineScheduleVariable(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 }
+
+// ResolvePipelineVariables returns a copy of vars in which the value of every
+// variable with a ValueSecretRef is read from its secret. The resolved values
+// are not meant to be written back to the spec.
+func ResolvePipelineVariables(ctx context.Context, kube client.Client, vars []v1alpha1.PipelineVariable) ([]v1alpha1.PipelineVariable, error) {
+	if vars == nil {
+		return nil, nil
+	}
+	resolved := make([]v1alpha1.PipelineVariable, len(vars))
+	for i, v := range vars {
+		resolved[i] = *v.DeepCopy()
+		ref := v.ValueSecretRef
+		if ref == nil {
+			continue
+		}
+		if v.Value != "" {
+			return nil, errors.Errorf(errPipelineVariableValue, v.Key)
+		}
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace}}
+		value, err := clients.GetKeyData(ctx, kube, s, ref.Key)
+		if err != nil {
+			return nil, errors.Wrapf(err, errResolvePipelineVariable, v.Key)
+		}
+		resolved[i].Value = string(value)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestResolvePipelineVariables(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "schedule", Namespace: "crossplane-system"},
+		Key:             "token",
+	}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("secret")}
+			return nil
+		},
+	}
+
+	type want struct {
+		vars []v1alpha1.PipelineVariable
+		err  error
+	}
+
+	cases := map[string]struct {
+		vars []v1alpha1.PipelineVariable
+		want want
+	}{
+		"NoVariables": {},
+		"Value": {
+			vars: []v1alpha1.PipelineVariable{{Key: "A", Value: "B"}},
+			want: want{vars: []v1alpha1.PipelineVariable{{Key: "A", Value: "B"}}},
+		},
+		"ValueSecretRef": {
+			vars: []v1alpha1.PipelineVariable{{Key: "TOKEN", ValueSecretRef: ref}},
+			want: want{vars: []v1alpha1.PipelineVariable{{Key: "TOKEN", Value: "secret", ValueSecretRef: ref}}},
+		},
+		"ValueAndValueSecretRef": {
+			vars: []v1alpha1.PipelineVariable{{Key: "TOKEN", Value: "B", ValueSecretRef: ref}},
+			want: want{err: errors.Errorf(errPipelineVariableValue, "TOKEN")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolvePipelineVariables(context.Background(), kube, tc.vars)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.vars, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// PipelineTriggerClient defines Gitlab pipeline trigger service operations
+type PipelineTriggerClient interface {
+	GetPipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	AddPipelineTrigger(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	EditPipelineTrigger(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error)
+	DeletePipelineTrigger(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewPipelineTriggerClient returns a new Gitlab pipeline trigger service
+func NewPipelineTriggerClient(cfg clients.Config) PipelineTriggerClient {
+	git := clients.NewClient(cfg)
+	return git.PipelineTriggers
+}
+
+// GeneratePipelineTriggerObservation is used to produce
+// v1alpha1.PipelineTriggerObservation from gitlab.PipelineTrigger.
+func GeneratePipelineTriggerObservation(t *gitlab.PipelineTrigger) v1alpha1.PipelineTriggerObservation {
+	if t == nil {
+		return v1alpha1.PipelineTriggerObservation{}
+	}
+	o := v1alpha1.PipelineTriggerObservation{
+		CreatedAt: clients.TimeToMetaTime(t.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(t.UpdatedAt),
+		LastUsed:  clients.TimeToMetaTime(t.LastUsed),
+	}
+	if t.Owner != nil {
+		o.Owner = GenerateOwnerObservation(t.Owner)
+	}
+	return o
+}
+
+// GenerateAddPipelineTriggerOptions generates pipeline trigger creation options
+func GenerateAddPipelineTriggerOptions(p *v1alpha1.PipelineTriggerParameters) *gitlab.AddPipelineTriggerOptions {
+	return &gitlab.AddPipelineTriggerOptions{
+		Description: &p.Description,
+	}
+}
+
+// GenerateEditPipelineTriggerOptions generates pipeline trigger update options
+func GenerateEditPipelineTriggerOptions(p *v1alpha1.PipelineTriggerParameters) *gitlab.EditPipelineTriggerOptions {
+	return &gitlab.EditPipelineTriggerOptions{
+		Description: &p.Description,
+	}
+}
+
+// IsPipelineTriggerUpToDate checks whether the trigger token matches p.
+func IsPipelineTriggerUpToDate(p *v1alpha1.PipelineTriggerParameters, t *gitlab.PipelineTrigger) bool {
+	return p.Description == t.Description
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGeneratePipelineTriggerObservation(t *testing.T) {
+	lastUsed := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		trigger *gitlab.PipelineTrigger
+		want    v1alpha1.PipelineTriggerObservation
+	}{
+		"Nil": {
+			trigger: nil,
+			want:    v1alpha1.PipelineTriggerObservation{},
+		},
+		"Full": {
+			trigger: &gitlab.PipelineTrigger{
+				ID:       42,
+				LastUsed: &lastUsed,
+				Owner:    &gitlab.User{ID: 1, Username: "root"},
+			},
+			want: v1alpha1.PipelineTriggerObservation{
+				LastUsed: &metav1.Time{Time: lastUsed},
+				Owner:    &v1alpha1.User{ID: 1, Username: "root"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePipelineTriggerObservation(tc.trigger)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
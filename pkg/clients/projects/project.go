@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -37,6 +38,23 @@ type Client interface {
 	CreateProject(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ForkProject(pid interface{}, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	TransferProject(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ArchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	UnarchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NamespaceClient defines Gitlab Namespace service operations
+type NamespaceClient interface {
+	GetNamespace(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error)
+}
+
+// NewNamespaceClient returns a new Gitlab Namespace service
+func NewNamespaceClient(cfg clients.Config) NamespaceClient {
+	git := clients.NewClient(cfg)
+	return git.Namespaces
 }
 
 // NewProjectClient returns a new Gitlab Project service
@@ -53,6 +71,19 @@ func IsErrorProjectNotFound(err error) bool {
 	return strings.Contains(err.Error(), errProjectNotFound)
 }
 
+// WithPermanentlyRemove returns a request option that asks GitLab to
+// immediately remove a project that is already marked for deletion. fullPath
+// must be the path with namespace of the project.
+func WithPermanentlyRemove(fullPath string) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("permanently_remove", "true")
+		q.Set("full_path", fullPath)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
 // GenerateObservation is used to produce v1alpha1.ProjectObservation from
 // gitlab.Project.
 func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { // nolint:gocyclo
@@ -268,6 +299,23 @@ func GenerateOwnerObservation(usr *gitlab.User) *v1alpha1.User {
 	return o
 }
 
+// GenerateForkProjectOptions generates fork options for the parameters that
+// GitLab accepts when forking a project. The remaining parameters are applied
+// with an edit once the fork exists.
+func GenerateForkProjectOptions(name string, p *v1alpha1.ProjectParameters) *gitlab.ForkProjectOptions {
+	// Name field overrides resource name
+	if p.Name != nil {
+		name = *p.Name
+	}
+	return &gitlab.ForkProjectOptions{
+		Name:        &name,
+		Path:        p.Path,
+		NamespaceID: p.NamespaceID,
+		Description: p.Description,
+		Visibility:  clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+	}
+}
+
 // GenerateCreateProjectOptions generates project creation options
 func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *gitlab.CreateProjectOptions {
 	// Name field overrides resource name
@@ -294,6 +342,7 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
 		ContainerRegistryEnabled:            p.ContainerRegistryEnabled,
 		SharedRunnersEnabled:                p.SharedRunnersEnabled,
+		GroupRunnersEnabled:                 p.GroupRunnersEnabled,
 		Visibility:                          clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
 		ImportURL:                           p.ImportURL,
 		PublicBuilds:                        p.PublicBuilds,
@@ -358,6 +407,7 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		ContainerExpirationPolicyAttributes: clients.ContainerExpirationPolicyAttributesV1alpha1ToGitlab(p.ContainerExpirationPolicyAttributes),
 		ContainerRegistryEnabled:            p.ContainerRegistryEnabled,
 		SharedRunnersEnabled:                p.SharedRunnersEnabled,
+		GroupRunnersEnabled:                 p.GroupRunnersEnabled,
 		Visibility:                          clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
 		ImportURL:                           p.ImportURL,
 		PublicBuilds:                        p.PublicBuilds,
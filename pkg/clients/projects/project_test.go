@@ -17,10 +17,13 @@ limitations under the License.
 package projects
 
 import (
+	"net/http"
+	neturl "net/url"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -98,6 +101,7 @@ var (
 	}
 	containerRegistryEnabled                  = true
 	sharedRunnersEnabled                      = true
+	groupRunnersEnabled                       = true
 	visibility                                = "private"
 	visibilityv1alpha1                        = v1alpha1.VisibilityValue(visibility)
 	importURL                                 = "import.url"
@@ -459,6 +463,7 @@ func TestGenerateCreateProjectOptions(t *testing.T) {
 					ContainerExpirationPolicyAttributes:       &v1alpha1ContainerExpirationPolicyAttributes,
 					ContainerRegistryEnabled:                  &containerRegistryEnabled,
 					SharedRunnersEnabled:                      &sharedRunnersEnabled,
+					GroupRunnersEnabled:                       &groupRunnersEnabled,
 					Visibility:                                &visibilityv1alpha1,
 					ImportURL:                                 &importURL,
 					PublicBuilds:                              &publicBuilds,
@@ -517,6 +522,7 @@ func TestGenerateCreateProjectOptions(t *testing.T) {
 				ContainerExpirationPolicyAttributes: &gitlabContainerExpirationPolicyAttributes,
 				ContainerRegistryEnabled:            &containerRegistryEnabled,
 				SharedRunnersEnabled:                &sharedRunnersEnabled,
+				GroupRunnersEnabled:                 &groupRunnersEnabled,
 				Visibility:                          clients.VisibilityValueStringToGitlab(visibility),
 				ImportURL:                           &importURL,
 				PublicBuilds:                        &publicBuilds,
@@ -603,6 +609,58 @@ func TestGenerateCreateProjectOptions(t *testing.T) {
 	}
 }
 
+func TestGenerateForkProjectOptions(t *testing.T) {
+	type args struct {
+		name       string
+		parameters *v1alpha1.ProjectParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *gitlab.ForkProjectOptions
+	}{
+		"AllFields": {
+			args: args{
+				name: name,
+				parameters: &v1alpha1.ProjectParameters{
+					Path:        &path,
+					NamespaceID: &namespaceID,
+					Description: &description,
+					Visibility:  &visibilityv1alpha1,
+					// Applied through an edit once the fork exists.
+					DefaultBranch: &defaultBranch,
+				},
+			},
+			want: &gitlab.ForkProjectOptions{
+				Name:        &name,
+				Path:        &path,
+				NamespaceID: &namespaceID,
+				Description: &description,
+				Visibility:  clients.VisibilityValueStringToGitlab(visibility),
+			},
+		},
+		"NameOverride": {
+			args: args{
+				name: name,
+				parameters: &v1alpha1.ProjectParameters{
+					Name: &overrideName,
+				},
+			},
+			want: &gitlab.ForkProjectOptions{
+				Name: &overrideName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateForkProjectOptions(tc.args.name, tc.args.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateEditProjectOptions(t *testing.T) {
 	type args struct {
 		name       string
@@ -633,6 +691,7 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 					ContainerExpirationPolicyAttributes:       &v1alpha1ContainerExpirationPolicyAttributes,
 					ContainerRegistryEnabled:                  &containerRegistryEnabled,
 					SharedRunnersEnabled:                      &sharedRunnersEnabled,
+					GroupRunnersEnabled:                       &groupRunnersEnabled,
 					Visibility:                                &visibilityv1alpha1,
 					ImportURL:                                 &importURL,
 					PublicBuilds:                              &publicBuilds,
@@ -687,6 +746,7 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 				ContainerExpirationPolicyAttributes: &gitlabContainerExpirationPolicyAttributes,
 				ContainerRegistryEnabled:            &containerRegistryEnabled,
 				SharedRunnersEnabled:                &sharedRunnersEnabled,
+				GroupRunnersEnabled:                 &groupRunnersEnabled,
 				Visibility:                          clients.VisibilityValueStringToGitlab(visibility),
 				ImportURL:                           &importURL,
 				PublicBuilds:                        &publicBuilds,
@@ -770,3 +830,18 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestWithPermanentlyRemove(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodDelete, "https://gitlab.example.com/api/v4/projects/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WithPermanentlyRemove(path)(req); err != nil {
+		t.Fatal(err)
+	}
+
+	want := neturl.Values{"permanently_remove": {"true"}, "full_path": {path}}
+	if diff := cmp.Diff(want, req.URL.Query()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProjectRunnerClient defines Gitlab project runner service operations
+type ProjectRunnerClient interface {
+	GetRunnerDetails(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	EnableProjectRunner(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error)
+	DisableProjectRunner(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewProjectRunnerClient returns a new Gitlab project runner service
+func NewProjectRunnerClient(cfg clients.Config) ProjectRunnerClient {
+	git := clients.NewClient(cfg)
+	return git.Runners
+}
+
+// GenerateProjectRunnerObservation is used to produce
+// v1alpha1.ProjectRunnerObservation from gitlab.RunnerDetails.
+func GenerateProjectRunnerObservation(r *gitlab.RunnerDetails) v1alpha1.ProjectRunnerObservation {
+	if r == nil {
+		return v1alpha1.ProjectRunnerObservation{}
+	}
+	return v1alpha1.ProjectRunnerObservation{
+		Description: r.Description,
+		Status:      r.Status,
+		Online:      r.Online,
+	}
+}
+
+// IsRunnerEnabledForProject checks whether the runner is enabled for the
+// project with the given ID.
+func IsRunnerEnabledForProject(r *gitlab.RunnerDetails, pid int) bool {
+	for _, p := range r.Projects {
+		if p.ID == pid {
+			return true
+		}
+	}
+	return false
+}
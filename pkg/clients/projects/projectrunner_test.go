@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestIsRunnerEnabledForProject(t *testing.T) {
+	r := &gitlab.RunnerDetails{}
+	if err := json.Unmarshal([]byte(`{"projects":[{"id":1},{"id":2}]}`), r); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		pid  int
+		want bool
+	}{
+		"Enabled": {
+			pid:  2,
+			want: true,
+		},
+		"NotEnabled": {
+			pid:  3,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRunnerEnabledForProject(r, tc.pid); got != tc.want {
+				t.Errorf("IsRunnerEnabledForProject() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ReleaseClient defines Gitlab release service operations
+type ReleaseClient interface {
+	GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*Release, *gitlab.Response, error)
+	CreateRelease(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	UpdateRelease(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+	DeleteRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error)
+}
+
+// ReleaseLinkClient defines Gitlab release link service operations
+type ReleaseLinkClient interface {
+	ListReleaseLinks(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error)
+	CreateReleaseLink(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	UpdateReleaseLink(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+	DeleteReleaseLink(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error)
+}
+
+// Release is a release along with its milestones, which go-gitlab does not
+// decode yet.
+type Release struct {
+	gitlab.Release
+	Milestones []*gitlab.Milestone `json:"milestones"`
+}
+
+type releaseClient struct {
+	*gitlab.ReleasesService
+	git *gitlab.Client
+}
+
+// GetRelease gets a release of a project by its tag name.
+func (c *releaseClient) GetRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*Release, *gitlab.Response, error) {
+	u := fmt.Sprintf("projects/%s/releases/%s", gitlab.PathEscape(fmt.Sprint(pid)), gitlab.PathEscape(tagName))
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Release)
+	resp, err := c.git.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// NewReleaseClient returns a new Gitlab release service
+func NewReleaseClient(cfg clients.Config) ReleaseClient {
+	git := clients.NewClient(cfg)
+	return &releaseClient{ReleasesService: git.Releases, git: git}
+}
+
+// NewReleaseLinkClient returns a new Gitlab release link service
+func NewReleaseLinkClient(cfg clients.Config) ReleaseLinkClient {
+	git := clients.NewClient(cfg)
+	return git.ReleaseLinks
+}
+
+// GenerateReleaseObservation is used to produce v1alpha1.ReleaseObservation
+// from a Release and its links.
+func GenerateReleaseObservation(r *Release, links []*gitlab.ReleaseLink) v1alpha1.ReleaseObservation {
+	if r == nil {
+		return v1alpha1.ReleaseObservation{}
+	}
+	o := v1alpha1.ReleaseObservation{
+		CommitSHA:       r.Commit.ID,
+		CreatedAt:       clients.TimeToMetaTime(r.CreatedAt),
+		ReleasedAt:      clients.TimeToMetaTime(r.ReleasedAt),
+		UpcomingRelease: r.UpcomingRelease,
+		WebURL:          r.Links.Self,
+	}
+	for _, l := range links {
+		o.Links = append(o.Links, v1alpha1.ReleaseLinkObservation{
+			ID:             l.ID,
+			Name:           l.Name,
+			URL:            l.URL,
+			DirectAssetURL: l.DirectAssetURL,
+			LinkType:       string(l.LinkType),
+		})
+	}
+	return o
+}
+
+// GenerateCreateReleaseOptions generates release creation options, including
+// its asset links.
+func GenerateCreateReleaseOptions(p *v1alpha1.ReleaseParameters) *gitlab.CreateReleaseOptions {
+	opt := &gitlab.CreateReleaseOptions{
+		Name:        p.Name,
+		TagName:     &p.TagName,
+		TagMessage:  p.TagMessage,
+		Description: p.Description,
+		Ref:         p.Ref,
+	}
+	if p.Milestones != nil {
+		opt.Milestones = &p.Milestones
+	}
+	if p.ReleasedAt != nil {
+		opt.ReleasedAt = &p.ReleasedAt.Time
+	}
+	if len(p.Links) > 0 {
+		opt.Assets = &gitlab.ReleaseAssetsOptions{}
+		for i := range p.Links {
+			l := &p.Links[i]
+			opt.Assets.Links = append(opt.Assets.Links, &gitlab.ReleaseAssetLinkOptions{
+				Name:     &l.Name,
+				URL:      &l.URL,
+				FilePath: l.FilePath,
+				LinkType: linkType(l.LinkType),
+			})
+		}
+	}
+	return opt
+}
+
+// GenerateUpdateReleaseOptions generates release update options. Links are
+// updated separately.
+func GenerateUpdateReleaseOptions(p *v1alpha1.ReleaseParameters) *gitlab.UpdateReleaseOptions {
+	opt := &gitlab.UpdateReleaseOptions{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.Milestones != nil {
+		opt.Milestones = &p.Milestones
+	}
+	if p.ReleasedAt != nil {
+		opt.ReleasedAt = &p.ReleasedAt.Time
+	}
+	return opt
+}
+
+// ReleaseLinksDiff holds the changes that turn the links of a release into
+// the desired ones.
+type ReleaseLinksDiff struct {
+	Create []*gitlab.CreateReleaseLinkOptions
+	Update map[int]*gitlab.UpdateReleaseLinkOptions
+	Delete []int
+}
+
+// Empty reports whether no changes are needed.
+func (d ReleaseLinksDiff) Empty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0
+}
+
+// DiffReleaseLinks matches desired and observed links by name and returns the
+// changes needed to turn the observed links into the desired ones.
+func DiffReleaseLinks(desired []v1alpha1.ReleaseLink, observed []*gitlab.ReleaseLink) ReleaseLinksDiff {
+	d := ReleaseLinksDiff{Update: map[int]*gitlab.UpdateReleaseLinkOptions{}}
+
+	byName := make(map[string]*gitlab.ReleaseLink, len(observed))
+	for _, o := range observed {
+		byName[o.Name] = o
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for i := range desired {
+		l := &desired[i]
+		wanted[l.Name] = true
+		o, ok := byName[l.Name]
+		if !ok {
+			d.Create = append(d.Create, &gitlab.CreateReleaseLinkOptions{
+				Name:     &l.Name,
+				URL:      &l.URL,
+				FilePath: l.FilePath,
+				LinkType: linkType(l.LinkType),
+			})
+			continue
+		}
+		if !isReleaseLinkUpToDate(l, o) {
+			d.Update[o.ID] = &gitlab.UpdateReleaseLinkOptions{
+				URL:      &l.URL,
+				FilePath: l.FilePath,
+				LinkType: linkType(l.LinkType),
+			}
+		}
+	}
+
+	for _, o := range observed {
+		if !wanted[o.Name] {
+			d.Delete = append(d.Delete, o.ID)
+		}
+	}
+	return d
+}
+
+// IsReleaseUpToDate checks whether the release and its links match p.
+func IsReleaseUpToDate(p *v1alpha1.ReleaseParameters, r *Release, links []*gitlab.ReleaseLink) bool {
+	if !clients.IsStringEqualToStringPtr(p.Name, r.Name) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, r.Description) {
+		return false
+	}
+	if p.ReleasedAt != nil && (r.ReleasedAt == nil || !p.ReleasedAt.Time.Equal(*r.ReleasedAt)) {
+		return false
+	}
+	if p.Milestones != nil && !cmp.Equal(p.Milestones, milestoneTitles(r.Milestones), cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return false
+	}
+	return DiffReleaseLinks(p.Links, links).Empty()
+}
+
+func milestoneTitles(ms []*gitlab.Milestone) []string {
+	titles := make([]string, len(ms))
+	for i, m := range ms {
+		titles[i] = m.Title
+	}
+	return titles
+}
+
+func isReleaseLinkUpToDate(l *v1alpha1.ReleaseLink, o *gitlab.ReleaseLink) bool {
+	if l.URL != o.URL {
+		return false
+	}
+	if t := linkType(l.LinkType); *t != o.LinkType {
+		return false
+	}
+	// GitLab only returns the direct asset URL of a link, which ends with its
+	// file path, or is the link URL when there is none.
+	if l.FilePath != nil {
+		return strings.HasSuffix(o.DirectAssetURL, *l.FilePath)
+	}
+	return o.DirectAssetURL == "" || o.DirectAssetURL == o.URL
+}
+
+func linkType(t *string) *gitlab.LinkTypeValue {
+	if t == nil {
+		return gitlab.LinkType(gitlab.OtherLinkType)
+	}
+	return gitlab.LinkType(gitlab.LinkTypeValue(*t))
+}
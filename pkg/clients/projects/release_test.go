@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func TestDiffReleaseLinks(t *testing.T) {
+	packageURL := "https://registry.example.com/app/1.0.0"
+	filePath := "/binaries/app"
+	runbook := "runbook"
+
+	cases := map[string]struct {
+		desired  []v1alpha1.ReleaseLink
+		observed []*gitlab.ReleaseLink
+		want     ReleaseLinksDiff
+	}{
+		"UpToDate": {
+			desired: []v1alpha1.ReleaseLink{{Name: "package", URL: packageURL, FilePath: &filePath}},
+			observed: []*gitlab.ReleaseLink{
+				{ID: 1, Name: "package", URL: packageURL, DirectAssetURL: "https://gitlab.example.com/-/releases/v1.0.0/downloads/binaries/app", LinkType: gitlab.OtherLinkType},
+			},
+			want: ReleaseLinksDiff{Update: map[int]*gitlab.UpdateReleaseLinkOptions{}},
+		},
+		"Changes": {
+			desired: []v1alpha1.ReleaseLink{
+				{Name: "package", URL: packageURL, LinkType: &runbook},
+				{Name: "image", URL: "https://registry.example.com/image"},
+			},
+			observed: []*gitlab.ReleaseLink{
+				{ID: 1, Name: "package", URL: packageURL, LinkType: gitlab.OtherLinkType},
+				{ID: 2, Name: "stale", URL: "https://example.com/stale", LinkType: gitlab.OtherLinkType},
+			},
+			want: ReleaseLinksDiff{
+				Create: []*gitlab.CreateReleaseLinkOptions{{
+					Name:     gitlab.String("image"),
+					URL:      gitlab.String("https://registry.example.com/image"),
+					LinkType: gitlab.LinkType(gitlab.OtherLinkType),
+				}},
+				Update: map[int]*gitlab.UpdateReleaseLinkOptions{1: {
+					URL:      &packageURL,
+					LinkType: gitlab.LinkType(gitlab.RunbookLinkType),
+				}},
+				Delete: []int{2},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffReleaseLinks(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetRelease(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fproject/releases/v1%2E0%2E0" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"tag_name":"v1.0.0","name":"Release 1.0.0","milestones":[{"id":1,"title":"v1.0"}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewReleaseClient(clients.Config{BaseURL: srv.URL})
+	got, _, err := c.GetRelease("group/project", "v1.0.0")
+	if err != nil {
+		t.Fatalf("GetRelease(...): %v", err)
+	}
+	want := &Release{
+		Release:    gitlab.Release{TagName: "v1.0.0", Name: "Release 1.0.0"},
+		Milestones: []*gitlab.Milestone{{ID: 1, Title: "v1.0"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsReleaseUpToDate(t *testing.T) {
+	name := "Release 1.0.0"
+	description := "First stable release"
+
+	cases := map[string]struct {
+		parameters *v1alpha1.ReleaseParameters
+		release    *Release
+		want       bool
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.ReleaseParameters{Name: &name, Description: &description},
+			release:    &Release{Release: gitlab.Release{Name: name, Description: description}},
+			want:       true,
+		},
+		"DescriptionChanged": {
+			parameters: &v1alpha1.ReleaseParameters{Name: &name, Description: &description},
+			release:    &Release{Release: gitlab.Release{Name: name, Description: "Release candidate"}},
+			want:       false,
+		},
+		"LinkMissing": {
+			parameters: &v1alpha1.ReleaseParameters{Links: []v1alpha1.ReleaseLink{{Name: "package", URL: "https://example.com"}}},
+			release:    &Release{},
+			want:       false,
+		},
+		"MilestonesUpToDate": {
+			parameters: &v1alpha1.ReleaseParameters{Milestones: []string{"v1.0", "Q3"}},
+			release:    &Release{Milestones: []*gitlab.Milestone{{Title: "Q3"}, {Title: "v1.0"}}},
+			want:       true,
+		},
+		"MilestoneMissing": {
+			parameters: &v1alpha1.ReleaseParameters{Milestones: []string{"v1.0", "Q3"}},
+			release:    &Release{Milestones: []*gitlab.Milestone{{Title: "v1.0"}}},
+			want:       false,
+		},
+		"MilestonesRemoved": {
+			parameters: &v1alpha1.ReleaseParameters{Milestones: []string{}},
+			release:    &Release{Milestones: []*gitlab.Milestone{{Title: "v1.0"}}},
+			want:       false,
+		},
+		"MilestonesUnset": {
+			parameters: &v1alpha1.ReleaseParameters{},
+			release:    &Release{Milestones: []*gitlab.Milestone{{Title: "v1.0"}}},
+			want:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReleaseUpToDate(tc.parameters, tc.release, nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
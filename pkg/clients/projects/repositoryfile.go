@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"crypto/sha1" // nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errContentSource = "exactly one of configMapKeyRef and secretKeyRef must be set for contentFrom"
+
+	// EncodingBase64 sends repository file content base64 encoded.
+	EncodingBase64 = "base64"
+)
+
+// RepositoryFileClient defines Gitlab repository file service operations
+type RepositoryFileClient interface {
+	GetFile(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error)
+	CreateFile(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	UpdateFile(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error)
+	DeleteFile(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewRepositoryFileClient returns a new Gitlab repository file service
+func NewRepositoryFileClient(cfg clients.Config) RepositoryFileClient {
+	git := clients.NewClient(cfg)
+	return git.RepositoryFiles
+}
+
+// CILintClient defines Gitlab CI lint service operations
+type CILintClient interface {
+	ProjectNamespaceLint(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error)
+}
+
+// NewCILintClient returns a new Gitlab CI lint service
+func NewCILintClient(cfg clients.Config) CILintClient {
+	git := clients.NewClient(cfg)
+	return git.Validate
+}
+
+// ContentSource returns the ConfigMap or Secret referenced by s, with only
+// its name and namespace set, and the key holding the content.
+func ContentSource(s *v1alpha1.ContentSource) (client.Object, string, error) {
+	obj, key, ok := clients.KeySource(s.ConfigMapKeyRef, s.SecretKeyRef)
+	if !ok {
+		return nil, "", errors.New(errContentSource)
+	}
+	return obj, key, nil
+}
+
+// BlobSHA returns the ID git, and so GitLab, assigns to a blob of content.
+func BlobSHA(content []byte) string {
+	h := sha1.New() // nolint:gosec
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// GenerateRepositoryFileObservation is used to produce
+// v1alpha1.RepositoryFileObservation from gitlab.File.
+func GenerateRepositoryFileObservation(f *gitlab.File) v1alpha1.RepositoryFileObservation {
+	if f == nil {
+		return v1alpha1.RepositoryFileObservation{}
+	}
+	return v1alpha1.RepositoryFileObservation{
+		BlobID:        f.BlobID,
+		CommitID:      f.CommitID,
+		LastCommitID:  f.LastCommitID,
+		ContentSHA256: f.SHA256,
+		Size:          f.Size,
+	}
+}
+
+// GenerateCreateRepositoryFileOptions generates options to commit content as
+// a new file.
+func GenerateCreateRepositoryFileOptions(p *v1alpha1.RepositoryFileParameters, content []byte) *gitlab.CreateFileOptions {
+	return &gitlab.CreateFileOptions{
+		Branch:          &p.Branch,
+		Encoding:        p.Encoding,
+		AuthorEmail:     p.AuthorEmail,
+		AuthorName:      p.AuthorName,
+		Content:         encodeContent(p.Encoding, content),
+		CommitMessage:   &p.CommitMessage,
+		ExecuteFilemode: p.ExecuteFilemode,
+	}
+}
+
+// GenerateUpdateRepositoryFileOptions generates options to commit content to
+// an existing file. The commit is rejected if lastCommitID is no longer the
+// last commit of the file.
+func GenerateUpdateRepositoryFileOptions(p *v1alpha1.RepositoryFileParameters, content []byte, lastCommitID string) *gitlab.UpdateFileOptions {
+	return &gitlab.UpdateFileOptions{
+		Branch:          &p.Branch,
+		Encoding:        p.Encoding,
+		AuthorEmail:     p.AuthorEmail,
+		AuthorName:      p.AuthorName,
+		Content:         encodeContent(p.Encoding, content),
+		CommitMessage:   &p.CommitMessage,
+		LastCommitID:    clients.StringToPtr(lastCommitID),
+		ExecuteFilemode: p.ExecuteFilemode,
+	}
+}
+
+// GenerateDeleteRepositoryFileOptions generates options to delete a file.
+func GenerateDeleteRepositoryFileOptions(p *v1alpha1.RepositoryFileParameters, lastCommitID string) *gitlab.DeleteFileOptions {
+	return &gitlab.DeleteFileOptions{
+		Branch:        &p.Branch,
+		AuthorEmail:   p.AuthorEmail,
+		AuthorName:    p.AuthorName,
+		CommitMessage: &p.CommitMessage,
+		LastCommitID:  clients.StringToPtr(lastCommitID),
+	}
+}
+
+// IsRepositoryFileUpToDate reports whether f holds content and matches the
+// remaining parameters of p.
+func IsRepositoryFileUpToDate(p *v1alpha1.RepositoryFileParameters, content []byte, f *gitlab.File) bool {
+	if f.BlobID != BlobSHA(content) {
+		return false
+	}
+	return clients.IsBoolEqualToBoolPtr(p.ExecuteFilemode, f.ExecuteFilemode)
+}
+
+// GenerateLintOptions generates options to validate content as the CI
+// configuration of a project.
+func GenerateLintOptions(content []byte) *gitlab.ProjectNamespaceLintOptions {
+	return &gitlab.ProjectNamespaceLintOptions{Content: clients.StringToPtr(string(content))}
+}
+
+// LintMessage joins the errors and warnings of a CI lint result into a
+// single message.
+func LintMessage(r *gitlab.ProjectLintResult) string {
+	msgs := make([]string, 0, len(r.Errors)+len(r.Warnings))
+	for _, e := range r.Errors {
+		msgs = append(msgs, "error: "+e)
+	}
+	for _, w := range r.Warnings {
+		msgs = append(msgs, "warning: "+w)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func encodeContent(encoding *string, content []byte) *string {
+	s := string(content)
+	if encoding != nil && *encoding == EncodingBase64 {
+		s = base64.StdEncoding.EncodeToString(content)
+	}
+	return &s
+}
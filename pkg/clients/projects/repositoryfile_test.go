@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestBlobSHA(t *testing.T) {
+	cases := map[string]struct {
+		content string
+		want    string
+	}{
+		"Empty": {
+			content: "",
+			want:    "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391",
+		},
+		"Text": {
+			content: "hello\n",
+			want:    "ce013625030ba8dba906f756967f9e9ca394464a",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := BlobSHA([]byte(tc.content))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateRepositoryFileOptions(t *testing.T) {
+	branch := "main"
+	commitMessage := "Add CODEOWNERS"
+	base64Encoding := "base64"
+	content := "hello\n"
+	encodedContent := "aGVsbG8K"
+
+	cases := map[string]struct {
+		parameters *v1alpha1.RepositoryFileParameters
+		want       *gitlab.CreateFileOptions
+	}{
+		"Text": {
+			parameters: &v1alpha1.RepositoryFileParameters{Branch: branch, CommitMessage: commitMessage},
+			want:       &gitlab.CreateFileOptions{Branch: &branch, CommitMessage: &commitMessage, Content: &content},
+		},
+		"Base64": {
+			parameters: &v1alpha1.RepositoryFileParameters{Branch: branch, CommitMessage: commitMessage, Encoding: &base64Encoding},
+			want:       &gitlab.CreateFileOptions{Branch: &branch, CommitMessage: &commitMessage, Encoding: &base64Encoding, Content: &encodedContent},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateRepositoryFileOptions(tc.parameters, []byte(content))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRepositoryFileUpToDate(t *testing.T) {
+	content := []byte("hello\n")
+	executable := true
+
+	cases := map[string]struct {
+		parameters *v1alpha1.RepositoryFileParameters
+		file       *gitlab.File
+		want       bool
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.RepositoryFileParameters{},
+			file:       &gitlab.File{BlobID: BlobSHA(content)},
+			want:       true,
+		},
+		"ContentChanged": {
+			parameters: &v1alpha1.RepositoryFileParameters{},
+			file:       &gitlab.File{BlobID: BlobSHA([]byte("bye\n"))},
+			want:       false,
+		},
+		"FilemodeChanged": {
+			parameters: &v1alpha1.RepositoryFileParameters{ExecuteFilemode: &executable},
+			file:       &gitlab.File{BlobID: BlobSHA(content)},
+			want:       false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRepositoryFileUpToDate(tc.parameters, content, tc.file)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLintMessage(t *testing.T) {
+	cases := map[string]struct {
+		result *gitlab.ProjectLintResult
+		want   string
+	}{
+		"Valid": {
+			result: &gitlab.ProjectLintResult{Valid: true},
+			want:   "",
+		},
+		"ErrorsAndWarnings": {
+			result: &gitlab.ProjectLintResult{
+				Errors:   []string{"jobs:test script can't be blank"},
+				Warnings: []string{"jobs:deploy may allow multiple pipelines to run"},
+			},
+			want: "error: jobs:test script can't be blank; warning: jobs:deploy may allow multiple pipelines to run",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LintMessage(tc.result)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
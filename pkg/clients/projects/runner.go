@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// RunnerClient defines Gitlab runner service operations
+type RunnerClient interface {
+	CreateUserRunner(opt *CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*UserRunner, *gitlab.Response, error)
+	GetRunnerDetails(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	UpdateRunnerDetails(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error)
+	RemoveRunner(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	ResetRunnerAuthenticationToken(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error)
+}
+
+// CreateUserRunnerOptions represents the options of the runner creation
+// endpoint, which go-gitlab does not support yet.
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner
+type CreateUserRunnerOptions struct {
+	RunnerType     *string   `url:"runner_type,omitempty" json:"runner_type,omitempty"`
+	GroupID        *int      `url:"group_id,omitempty" json:"group_id,omitempty"`
+	ProjectID      *int      `url:"project_id,omitempty" json:"project_id,omitempty"`
+	Description    *string   `url:"description,omitempty" json:"description,omitempty"`
+	Paused         *bool     `url:"paused,omitempty" json:"paused,omitempty"`
+	Locked         *bool     `url:"locked,omitempty" json:"locked,omitempty"`
+	RunUntagged    *bool     `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	TagList        *[]string `url:"tag_list,comma,omitempty" json:"tag_list,omitempty"`
+	AccessLevel    *string   `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout *int      `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+}
+
+// UserRunner is a runner returned by the runner creation endpoint, along
+// with its authentication token.
+type UserRunner struct {
+	ID             int        `json:"id"`
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+type runnerClient struct {
+	*gitlab.RunnersService
+	git *gitlab.Client
+}
+
+// CreateUserRunner creates a runner owned by the authenticated user.
+func (c *runnerClient) CreateUserRunner(opt *CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*UserRunner, *gitlab.Response, error) {
+	req, err := c.git.NewRequest(http.MethodPost, "user/runners", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(UserRunner)
+	resp, err := c.git.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// NewRunnerClient returns a new Gitlab runner service
+func NewRunnerClient(cfg clients.Config) RunnerClient {
+	git := clients.NewClient(cfg)
+	return &runnerClient{RunnersService: git.Runners, git: git}
+}
+
+// GenerateRunnerObservation is used to produce v1alpha1.RunnerObservation
+// from gitlab.RunnerDetails.
+func GenerateRunnerObservation(r *gitlab.RunnerDetails) v1alpha1.RunnerObservation {
+	if r == nil {
+		return v1alpha1.RunnerObservation{}
+	}
+	return v1alpha1.RunnerObservation{
+		Status:       r.Status,
+		Online:       r.Online,
+		IPAddress:    r.IPAddress,
+		Version:      r.Version,
+		Platform:     r.Platform,
+		Architecture: r.Architecture,
+		ContactedAt:  clients.TimeToMetaTime(r.ContactedAt),
+	}
+}
+
+// GenerateCreateUserRunnerOptions generates runner creation options
+func GenerateCreateUserRunnerOptions(p *v1alpha1.RunnerParameters) *CreateUserRunnerOptions {
+	opt := &CreateUserRunnerOptions{
+		RunnerType:     &p.RunnerType,
+		Description:    p.Description,
+		Paused:         p.Paused,
+		Locked:         p.Locked,
+		RunUntagged:    p.RunUntagged,
+		AccessLevel:    p.AccessLevel,
+		MaximumTimeout: p.MaximumTimeout,
+	}
+	switch p.RunnerType {
+	case v1alpha1.GroupTypeRunner:
+		opt.GroupID = p.GroupID
+	case v1alpha1.ProjectTypeRunner:
+		opt.ProjectID = p.ProjectID
+	}
+	if p.TagList != nil {
+		opt.TagList = &p.TagList
+	}
+	return opt
+}
+
+// GenerateUpdateRunnerDetailsOptions generates runner update options
+func GenerateUpdateRunnerDetailsOptions(p *v1alpha1.RunnerParameters) *gitlab.UpdateRunnerDetailsOptions {
+	opt := &gitlab.UpdateRunnerDetailsOptions{
+		Description:    p.Description,
+		Paused:         p.Paused,
+		Locked:         p.Locked,
+		RunUntagged:    p.RunUntagged,
+		AccessLevel:    p.AccessLevel,
+		MaximumTimeout: p.MaximumTimeout,
+	}
+	if p.TagList != nil {
+		opt.TagList = &p.TagList
+	}
+	return opt
+}
+
+// IsRunnerUpToDate checks whether the runner matches p. Fields that are not
+// set in p are ignored and tags are compared regardless of their order.
+func IsRunnerUpToDate(p *v1alpha1.RunnerParameters, r *gitlab.RunnerDetails) bool {
+	if !clients.IsStringEqualToStringPtr(p.Description, r.Description) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.Paused, r.Paused) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.Locked, r.Locked) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.RunUntagged, r.RunUntagged) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.AccessLevel, r.AccessLevel) {
+		return false
+	}
+	if !clients.IsIntEqualToIntPtr(p.MaximumTimeout, r.MaximumTimeout) {
+		return false
+	}
+	if p.TagList != nil && !cmp.Equal(p.TagList, r.TagList, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return false
+	}
+	return true
+}
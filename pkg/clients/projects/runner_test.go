@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateCreateUserRunnerOptions(t *testing.T) {
+	projectID := 1
+	groupID := 2
+
+	cases := map[string]struct {
+		params *v1alpha1.RunnerParameters
+		want   *CreateUserRunnerOptions
+	}{
+		"Instance": {
+			params: &v1alpha1.RunnerParameters{RunnerType: v1alpha1.InstanceTypeRunner, ProjectID: &projectID, GroupID: &groupID},
+			want:   &CreateUserRunnerOptions{RunnerType: gitlab.String(v1alpha1.InstanceTypeRunner)},
+		},
+		"Group": {
+			params: &v1alpha1.RunnerParameters{RunnerType: v1alpha1.GroupTypeRunner, GroupID: &groupID},
+			want:   &CreateUserRunnerOptions{RunnerType: gitlab.String(v1alpha1.GroupTypeRunner), GroupID: &groupID},
+		},
+		"Project": {
+			params: &v1alpha1.RunnerParameters{RunnerType: v1alpha1.ProjectTypeRunner, ProjectID: &projectID, TagList: []string{"docker"}},
+			want:   &CreateUserRunnerOptions{RunnerType: gitlab.String(v1alpha1.ProjectTypeRunner), ProjectID: &projectID, TagList: &[]string{"docker"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateUserRunnerOptions(tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRunnerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.RunnerParameters
+		runner *gitlab.RunnerDetails
+		want   bool
+	}{
+		"Unset": {
+			params: &v1alpha1.RunnerParameters{},
+			runner: &gitlab.RunnerDetails{Description: "runner", TagList: []string{"docker"}},
+			want:   true,
+		},
+		"TagsInOtherOrder": {
+			params: &v1alpha1.RunnerParameters{TagList: []string{"docker", "linux"}},
+			runner: &gitlab.RunnerDetails{TagList: []string{"linux", "docker"}},
+			want:   true,
+		},
+		"TagsRemoved": {
+			params: &v1alpha1.RunnerParameters{TagList: []string{}},
+			runner: &gitlab.RunnerDetails{TagList: []string{"docker"}},
+			want:   false,
+		},
+		"PausedChanged": {
+			params: &v1alpha1.RunnerParameters{Paused: gitlab.Bool(true)},
+			runner: &gitlab.RunnerDetails{},
+			want:   false,
+		},
+		"MaximumTimeoutChanged": {
+			params: &v1alpha1.RunnerParameters{MaximumTimeout: gitlab.Int(3600)},
+			runner: &gitlab.RunnerDetails{MaximumTimeout: 600},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRunnerUpToDate(tc.params, tc.runner); got != tc.want {
+				t.Errorf("IsRunnerUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// TagClient defines Gitlab tag service operations
+type TagClient interface {
+	GetTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	CreateTag(pid interface{}, opt *gitlab.CreateTagOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Tag, *gitlab.Response, error)
+	DeleteTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewTagClient returns a new Gitlab tag service
+func NewTagClient(cfg clients.Config) TagClient {
+	git := clients.NewClient(cfg)
+	return git.Tags
+}
+
+// GenerateTagObservation is used to produce v1alpha1.TagObservation from
+// gitlab.Tag.
+func GenerateTagObservation(t *gitlab.Tag) v1alpha1.TagObservation {
+	if t == nil {
+		return v1alpha1.TagObservation{}
+	}
+	o := v1alpha1.TagObservation{
+		Target:    t.Target,
+		Protected: t.Protected,
+	}
+	if t.Commit != nil {
+		o.CommitSHA = t.Commit.ID
+	}
+	return o
+}
+
+// GenerateCreateTagOptions generates tag creation options
+func GenerateCreateTagOptions(p *v1alpha1.TagParameters) *gitlab.CreateTagOptions {
+	return &gitlab.CreateTagOptions{
+		TagName: &p.Name,
+		Ref:     &p.Ref,
+		Message: p.Message,
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateTagObservation(t *testing.T) {
+	sha := "0b4bc9a49b562e85de7cc9e834518ea6828729b9"
+	target := "6b3e7a5d4f2c1b0a9e8d7c6b5a4f3e2d1c0b9a8f"
+
+	cases := map[string]struct {
+		tag  *gitlab.Tag
+		want v1alpha1.TagObservation
+	}{
+		"Nil": {
+			want: v1alpha1.TagObservation{},
+		},
+		"AnnotatedTag": {
+			tag: &gitlab.Tag{
+				Name:      "v1.0.0",
+				Message:   "First release",
+				Commit:    &gitlab.Commit{ID: sha},
+				Target:    target,
+				Protected: true,
+			},
+			want: v1alpha1.TagObservation{CommitSHA: sha, Target: target, Protected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTagObservation(tc.tag)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateTagOptions(t *testing.T) {
+	name := "v1.0.0"
+	ref := "main"
+	message := "First release"
+
+	cases := map[string]struct {
+		parameters *v1alpha1.TagParameters
+		want       *gitlab.CreateTagOptions
+	}{
+		"Lightweight": {
+			parameters: &v1alpha1.TagParameters{Name: name, Ref: ref},
+			want:       &gitlab.CreateTagOptions{TagName: &name, Ref: &ref},
+		},
+		"Annotated": {
+			parameters: &v1alpha1.TagParameters{Name: name, Ref: ref, Message: &message},
+			want:       &gitlab.CreateTagOptions{TagName: &name, Ref: &ref, Message: &message},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateTagOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
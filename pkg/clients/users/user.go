@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
@@ -28,6 +29,20 @@ const (
 	errPullUserID  = "cant determine user by userName. Amount of users received: %v"
 )
 
+// Client defines Gitlab User service operations used to manage users
+type Client interface {
+	GetUser(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+	CreateUser(opt *gitlab.CreateUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+	ModifyUser(user int, opt *gitlab.ModifyUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+	DeleteUser(user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewClient returns a new Gitlab User service used to manage users
+func NewClient(cfg clients.Config) Client {
+	git := clients.NewClient(cfg)
+	return git.Users
+}
+
 // UserClient defines Gitlab User service operations
 type UserClient interface {
 	ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
@@ -39,6 +54,18 @@ func NewUserClient(cfg clients.Config) UserClient {
 	return git.Users
 }
 
+// CurrentUserClient defines Gitlab User service operations on the
+// authenticated user
+type CurrentUserClient interface {
+	CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+}
+
+// NewCurrentUserClient returns a new Gitlab User service
+func NewCurrentUserClient(cfg clients.Config) CurrentUserClient {
+	git := clients.NewClient(cfg)
+	return git.Users
+}
+
 // GetUserID gets Gitlab userID by Gitlab username
 func GetUserID(git UserClient, username string) (*int, error) {
 	userOptions := gitlab.ListUsersOptions{Username: &username}
@@ -54,3 +81,77 @@ func GetUserID(git UserClient, username string) (*int, error) {
 
 	return &pulledUserID, nil
 }
+
+// GenerateObservation is used to produce v1alpha1.UserObservation from
+// gitlab.User.
+func GenerateObservation(usr *gitlab.User) v1alpha1.UserObservation {
+	if usr == nil {
+		return v1alpha1.UserObservation{}
+	}
+
+	return v1alpha1.UserObservation{
+		ID:          &usr.ID,
+		State:       &usr.State,
+		WebURL:      &usr.WebURL,
+		AvatarURL:   &usr.AvatarURL,
+		Bot:         &usr.Bot,
+		NamespaceID: &usr.NamespaceID,
+		CreatedAt:   clients.TimeToMetaTime(usr.CreatedAt),
+		ConfirmedAt: clients.TimeToMetaTime(usr.ConfirmedAt),
+	}
+}
+
+// GenerateCreateUserOptions generates user creation options
+func GenerateCreateUserOptions(name string, p *v1alpha1.UserParameters) *gitlab.CreateUserOptions {
+	// Name field overrides resource name
+	if p.Name != nil {
+		name = *p.Name
+	}
+	return &gitlab.CreateUserOptions{
+		Username:            &p.Username,
+		Email:               &p.Email,
+		Name:                &name,
+		ForceRandomPassword: p.ForceRandomPassword,
+		ResetPassword:       p.ResetPassword,
+		SkipConfirmation:    p.SkipConfirmation,
+		Admin:               p.Admin,
+		External:            p.External,
+		CanCreateGroup:      p.CanCreateGroup,
+		PrivateProfile:      p.PrivateProfile,
+		ProjectsLimit:       p.ProjectsLimit,
+		Bio:                 p.Bio,
+		Location:            p.Location,
+		Organization:        p.Organization,
+		JobTitle:            p.JobTitle,
+		WebsiteURL:          p.WebsiteURL,
+		Note:                p.Note,
+		ExternUID:           p.ExternUID,
+		Provider:            p.Provider,
+	}
+}
+
+// GenerateModifyUserOptions generates user modification options
+func GenerateModifyUserOptions(name string, p *v1alpha1.UserParameters) *gitlab.ModifyUserOptions {
+	// Name field overrides resource name
+	if p.Name != nil {
+		name = *p.Name
+	}
+	return &gitlab.ModifyUserOptions{
+		Username:       &p.Username,
+		Email:          &p.Email,
+		Name:           &name,
+		Admin:          p.Admin,
+		External:       p.External,
+		CanCreateGroup: p.CanCreateGroup,
+		PrivateProfile: p.PrivateProfile,
+		ProjectsLimit:  p.ProjectsLimit,
+		Bio:            p.Bio,
+		Location:       p.Location,
+		Organization:   p.Organization,
+		JobTitle:       p.JobTitle,
+		WebsiteURL:     p.WebsiteURL,
+		Note:           p.Note,
+		ExternUID:      p.ExternUID,
+		Provider:       p.Provider,
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package users
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
+)
+
+var (
+	name           = "example-user"
+	displayName    = "Example User"
+	username       = "example-bot"
+	email          = "bot@example.com"
+	forceRandom    = true
+	skipConfirm    = true
+	admin          = false
+	external       = true
+	canCreateGroup = false
+	projectsLimit  = 10
+	bio            = "bio"
+	note           = "managed by crossplane"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	id := 42
+	state := "active"
+	webURL := "https://gitlab.example.com/example-bot"
+	avatarURL := "https://gitlab.example.com/avatar.png"
+	bot := false
+	namespaceID := 7
+	now := time.Now()
+
+	type args struct {
+		u *gitlab.User
+	}
+	cases := map[string]struct {
+		args args
+		want v1alpha1.UserObservation
+	}{
+		"Full": {
+			args: args{
+				u: &gitlab.User{
+					ID:          id,
+					State:       state,
+					WebURL:      webURL,
+					AvatarURL:   avatarURL,
+					Bot:         bot,
+					NamespaceID: namespaceID,
+					CreatedAt:   &now,
+					ConfirmedAt: &now,
+				},
+			},
+			want: v1alpha1.UserObservation{
+				ID:          &id,
+				State:       &state,
+				WebURL:      &webURL,
+				AvatarURL:   &avatarURL,
+				Bot:         &bot,
+				NamespaceID: &namespaceID,
+				CreatedAt:   &metav1.Time{Time: now},
+				ConfirmedAt: &metav1.Time{Time: now},
+			},
+		},
+		"Nil": {
+			args: args{},
+			want: v1alpha1.UserObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.args.u)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateUserOptions(t *testing.T) {
+	type args struct {
+		name       string
+		parameters *v1alpha1.UserParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *gitlab.CreateUserOptions
+	}{
+		"AllFields": {
+			args: args{
+				name: name,
+				parameters: &v1alpha1.UserParameters{
+					Username:            username,
+					Email:               email,
+					Name:                &displayName,
+					ForceRandomPassword: &forceRandom,
+					SkipConfirmation:    &skipConfirm,
+					Admin:               &admin,
+					External:            &external,
+					CanCreateGroup:      &canCreateGroup,
+					ProjectsLimit:       &projectsLimit,
+					Bio:                 &bio,
+					Note:                &note,
+				},
+			},
+			want: &gitlab.CreateUserOptions{
+				Username:            &username,
+				Email:               &email,
+				Name:                &displayName,
+				ForceRandomPassword: &forceRandom,
+				SkipConfirmation:    &skipConfirm,
+				Admin:               &admin,
+				External:            &external,
+				CanCreateGroup:      &canCreateGroup,
+				ProjectsLimit:       &projectsLimit,
+				Bio:                 &bio,
+				Note:                &note,
+			},
+		},
+		"NameFromMetadata": {
+			args: args{
+				name: name,
+				parameters: &v1alpha1.UserParameters{
+					Username: username,
+					Email:    email,
+				},
+			},
+			want: &gitlab.CreateUserOptions{
+				Username: &username,
+				Email:    &email,
+				Name:     &name,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateUserOptions(tc.args.name, tc.args.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyUserOptions(t *testing.T) {
+	type args struct {
+		name       string
+		parameters *v1alpha1.UserParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *gitlab.ModifyUserOptions
+	}{
+		"CreateOnlyFieldsIgnored": {
+			args: args{
+				name: name,
+				parameters: &v1alpha1.UserParameters{
+					Username:            username,
+					Email:               email,
+					ForceRandomPassword: &forceRandom,
+					SkipConfirmation:    &skipConfirm,
+					External:            &external,
+					Note:                &note,
+				},
+			},
+			want: &gitlab.ModifyUserOptions{
+				Username: &username,
+				Email:    &email,
+				Name:     &name,
+				External: &external,
+				Note:     &note,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyUserOptions(tc.args.name, tc.args.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,16 +24,29 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups"
 	groupsDeployToken "github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/deploytokens"
+	groupsGroupShares "github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/groupshares"
 	groupsMembers "github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/members"
 	groupsVariables "github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/variables"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects"
 	projectsAccessToken "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/accesstokens"
+	projectsBranches "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/branches"
+	projectsClusterAgents "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/clusteragents"
+	projectsClusterAgentTokens "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/clusteragenttokens"
 	projectsDeployKeys "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploykeys"
 	projectsDeployToken "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploytokens"
+	projectsExports "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/exports"
 	projectsHooks "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/hooks"
 	projectsMembers "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/members"
+	projectsPipelines "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelines"
 	projectsPipelineschedules "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelineschedules"
+	projectsPipelineTriggers "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelinetriggers"
+	projectsProjectRunners "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projectrunners"
+	projectsReleases "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/releases"
+	projectsRepositoryFiles "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/repositoryfiles"
+	projectsRunners "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/runners"
+	projectsTags "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/tags"
 	projectsVariables "github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/users"
 )
 
 // Setup creates all Gitlab API controllers with the supplied logger and adds
@@ -45,6 +58,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		groupsMembers.SetupMember,
 		groupsDeployToken.SetupDeployToken,
 		groupsVariables.SetupVariable,
+		groupsGroupShares.SetupGroupShare,
 		projects.SetupProject,
 		projectsHooks.SetupHook,
 		projectsMembers.SetupMember,
@@ -53,6 +67,18 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		projectsVariables.SetupVariable,
 		projectsDeployKeys.SetupDeployKey,
 		projectsPipelineschedules.SetupPipelineSchedule,
+		projectsExports.SetupProjectExport,
+		projectsRepositoryFiles.SetupRepositoryFile,
+		projectsBranches.SetupBranch,
+		projectsTags.SetupTag,
+		projectsReleases.SetupRelease,
+		projectsPipelineTriggers.SetupPipelineTrigger,
+		projectsPipelines.SetupPipeline,
+		projectsRunners.SetupRunner,
+		projectsProjectRunners.SetupProjectRunner,
+		projectsClusterAgents.SetupClusterAgent,
+		projectsClusterAgentTokens.SetupClusterAgentToken,
+		users.SetupUser,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -17,7 +17,9 @@ limitations under the License.
 package groups
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -36,42 +38,58 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 )
 
 const (
-	errNotGroup          = "managed resource is not a Gitlab Group custom resource"
-	errIDNotInt          = "specified ID is not an integer"
-	errGetFailed         = "cannot get Gitlab Group"
-	errCreateFailed      = "cannot create Gitlab Group"
-	errUpdateFailed      = "cannot update Gitlab Group"
-	errShareFailed       = "cannot share Gitlab Group with: %v"
-	errUnshareFailed     = "cannot unshare Gitlab Group from: %v"
-	errDeleteFailed      = "cannot delete Gitlab Group"
-	errMissingGroupID    = "missing group ID for group to share with"
-	errSWGMissingGroupID = "FOllowing SharedWithGroup is missing GroupID: %v"
-	errLateInitialize    = "Error during LateInitialization: "
+	errNotGroup           = "managed resource is not a Gitlab Group custom resource"
+	errGetFailed          = "cannot get Gitlab Group"
+	errCreateFailed       = "cannot create Gitlab Group"
+	errUpdateFailed       = "cannot update Gitlab Group"
+	errShareFailed        = "cannot share Gitlab Group with: %v"
+	errUnshareFailed      = "cannot unshare Gitlab Group from: %v"
+	errDeleteFailed       = "cannot delete Gitlab Group"
+	errRemoveFailed       = "cannot permanently remove Gitlab Group"
+	errRestoreFailed      = "cannot restore Gitlab Group"
+	errGetParentFailed    = "cannot get parent Gitlab Group %d"
+	errAdoptFailed        = "cannot look up existing Gitlab Group %s"
+	errMissingGroupID     = "missing group ID for group to share with"
+	errSWGMissingGroupID  = "FOllowing SharedWithGroup is missing GroupID: %v"
+	errLateInitialize     = "Error during LateInitialization: "
+	errTransferFailed     = "cannot transfer Gitlab Group to parent %d"
+	errTransferNotAllowed = "parentId changed from %d to %d but the group is missing the %s: \"true\" annotation"
+	errAvatarFailed       = "cannot read Gitlab Group avatar"
+	errKubeUpdateFailed   = "cannot update Gitlab Group custom resource"
+)
+
+const (
+	reasonTransfer event.Reason = "TransferGroup"
+	reasonRestore  event.Reason = "RestoreGroup"
+	reasonAdopt    event.Reason = "AdoptGroup"
 )
 
 // SetupGroup adds a controller that reconciles Groups.
 func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.GroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Group{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.GroupKubernetesGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: groups.NewGroupClient}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(recorder)))
 }
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) groups.Client
 }
 
@@ -84,12 +102,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.Client
+	kube     client.Client
+	recorder event.Recorder
+	client   groups.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -103,12 +122,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	groupID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errIDNotInt)
-	}
-
-	grp, res, err := e.client.GetGroup(groupID, nil)
+	// The external name is either the numeric ID or the full path of the
+	// group, GitLab accepts both.
+	grp, res, err := e.client.GetGroup(externalName, nil)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -116,13 +132,27 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// Groups pending delayed deletion are still returned by GitLab. Unless
+	// they should be removed for good, they are as good as gone.
+	if meta.WasDeleted(cr) && grp.MarkedForDeletionOn != nil && !permanentlyRemove(&cr.Spec.ForProvider) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// A path is only used to find the group, from then on it is tracked by
+	// its ID so that it is still found after a rename or a transfer.
+	idPinned := false
+	if _, err := strconv.Atoi(externalName); err != nil {
+		meta.SetExternalName(cr, strconv.Itoa(grp.ID))
+		idPinned = true
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 
 	err = lateInitialize(&cr.Spec.ForProvider, grp)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
-	isResourceLateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
+	isResourceLateInitialized := idPinned || !cmp.Equal(current, &cr.Spec.ForProvider)
 
 	cr.Status.AtProvider = groups.GenerateObservation(grp)
 	cr.Status.SetConditions(xpv1.Available())
@@ -130,6 +160,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
+	if isUpToDate && cr.Spec.ForProvider.Avatar != nil {
+		_, sum, err := e.avatar(ctx, cr.Spec.ForProvider.Avatar)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		isUpToDate = grp.AvatarURL != "" && sum == cr.GetAnnotations()[common.AnnotationKeyAvatarHash]
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -145,10 +182,29 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotGroup)
 	}
 
-	grp, _, err := e.client.CreateGroup(
-		groups.GenerateCreateGroupOptions(cr.Name, &cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
-	)
+	if cr.Spec.ForProvider.AdoptExisting != nil && *cr.Spec.ForProvider.AdoptExisting {
+		grp, err := e.findExisting(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if grp != nil {
+			meta.SetExternalName(cr, strconv.Itoa(grp.ID))
+			e.recorder.Event(cr, event.Normal(reasonAdopt, fmt.Sprintf("Adopted existing group %s", grp.FullPath)))
+			return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+		}
+	}
+
+	opt := groups.GenerateCreateGroupOptions(cr.Name, &cr.Spec.ForProvider)
+	if a := cr.Spec.ForProvider.Avatar; a != nil {
+		av, sum, err := e.avatar(ctx, a)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		opt.Avatar = av
+		meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyAvatarHash: sum})
+	}
+
+	grp, _, err := e.client.CreateGroup(opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -157,19 +213,86 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
 }
 
+// avatar reads the image referenced by a and returns it ready for upload
+// along with the hash of its content.
+func (e *external) avatar(ctx context.Context, a *common.Avatar) (*gitlab.GroupAvatar, string, error) {
+	obj, key, err := clients.AvatarSource(a)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errAvatarFailed)
+	}
+	img, sum, err := clients.GetAvatar(ctx, e.kube, obj, key)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errAvatarFailed)
+	}
+	return &gitlab.GroupAvatar{Filename: key, Image: bytes.NewReader(img)}, sum, nil
+}
+
+// findExisting returns the group living at the parent and path of p, or nil
+// if there is none.
+func (e *external) findExisting(ctx context.Context, p *v1alpha1.GroupParameters) (*gitlab.Group, error) {
+	fullPath := p.Path
+	if p.ParentID != nil && *p.ParentID != 0 {
+		parent, _, err := e.client.GetGroup(*p.ParentID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrapf(err, errGetParentFailed, *p.ParentID)
+		}
+		fullPath = parent.FullPath + "/" + p.Path
+	}
+
+	grp, res, err := e.client.GetGroup(fullPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, errAdoptFailed, fullPath)
+	}
+	return grp, nil
+}
+
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
 	cr, ok := mg.(*v1alpha1.Group)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotGroup)
 	}
-	grp, _, err := e.client.UpdateGroup(
-		meta.GetExternalName(cr),
-		groups.GenerateEditGroupOptions(cr.Name, &cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
-	)
+
+	if cr.Status.AtProvider.MarkedForDeletionOn != nil {
+		e.recorder.Event(cr, event.Normal(reasonRestore, "Restoring group marked for deletion"))
+		if _, _, err := e.client.RestoreGroup(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			err = errors.Wrap(err, errRestoreFailed)
+			e.recorder.Event(cr, event.Warning(reasonRestore, err))
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if err := e.transfer(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	opt := groups.GenerateEditGroupOptions(cr.Name, &cr.Spec.ForProvider)
+	avatarHash := ""
+	if a := cr.Spec.ForProvider.Avatar; a != nil {
+		av, sum, err := e.avatar(ctx, a)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if sum != cr.GetAnnotations()[common.AnnotationKeyAvatarHash] || cr.Status.AtProvider.AvatarURL == nil || *cr.Status.AtProvider.AvatarURL == "" {
+			opt.Avatar = av
+			avatarHash = sum
+		}
+	}
+
+	grp, _, err := e.client.UpdateGroup(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
+	if avatarHash != "" {
+		// The reconciler only saves the status after an update, store the
+		// annotation holding the hash of the uploaded avatar here.
+		meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyAvatarHash: avatarHash})
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
 
 	if len(cr.Spec.ForProvider.SharedWithGroups) > 0 {
 		for _, sh := range cr.Spec.ForProvider.SharedWithGroups {
@@ -192,7 +315,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	if len(grp.SharedWithGroups) > 0 {
+	// Shares are only removed when spec.forProvider.sharedWithGroups is set,
+	// so that they can be managed with GroupShare resources instead.
+	if cr.Spec.ForProvider.SharedWithGroups != nil {
 		for _, sh := range grp.SharedWithGroups {
 			isNotUnshared, err := notUnshared(sh.GroupID, cr.Spec.ForProvider.SharedWithGroups)
 			if err != nil {
@@ -210,18 +335,74 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalUpdate{}, nil
 }
 
+// transfer moves the group to spec.forProvider.parentId, or to the top level
+// if it is 0, when it currently lives under a different parent. The move only
+// happens if the group carries the AnnotationKeyAllowTransfer annotation.
+func (e *external) transfer(ctx context.Context, cr *v1alpha1.Group) error {
+	to := cr.Spec.ForProvider.ParentID
+	from := cr.Status.AtProvider.ParentID
+	if to == nil || from == nil || *to == *from {
+		return nil
+	}
+
+	if cr.GetAnnotations()[v1alpha1.AnnotationKeyAllowTransfer] != "true" {
+		err := errors.Errorf(errTransferNotAllowed, *from, *to, v1alpha1.AnnotationKeyAllowTransfer)
+		e.recorder.Event(cr, event.Warning(reasonTransfer, err))
+		return err
+	}
+
+	opt := &gitlab.TransferSubGroupOptions{}
+	if *to != 0 {
+		opt.GroupID = to
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonTransfer, fmt.Sprintf("Transferring group from parent %d to parent %d", *from, *to)))
+	_, _, err := e.client.TransferSubGroup(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+	if err != nil {
+		err = errors.Wrapf(err, errTransferFailed, *to)
+		e.recorder.Event(cr, event.Warning(reasonTransfer, err))
+		return err
+	}
+	e.recorder.Event(cr, event.Normal(reasonTransfer, fmt.Sprintf("Transferred group from parent %d to parent %d", *from, *to)))
+	return nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.Group)
 	if !ok {
 		return errors.New(errNotGroup)
 	}
 
+	// GitLab only permanently removes groups that are already marked for
+	// deletion, so the first call marks the group and the next reconcile
+	// removes it for good.
+	if permanentlyRemove(&cr.Spec.ForProvider) && cr.Status.AtProvider.MarkedForDeletionOn != nil && cr.Status.AtProvider.FullPath != nil {
+		res, err := e.client.DeleteGroup(
+			meta.GetExternalName(cr),
+			groups.WithPermanentlyRemove(*cr.Status.AtProvider.FullPath),
+			gitlab.WithContext(ctx),
+		)
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrap(err, errRemoveFailed)
+		}
+		return nil
+	}
+
 	_, err := e.client.DeleteGroup(meta.GetExternalName(cr), gitlab.WithContext(ctx))
 	return errors.Wrap(err, errDeleteFailed)
 }
 
+// permanentlyRemove reports whether a group marked for deletion should be
+// removed immediately.
+func permanentlyRemove(p *v1alpha1.GroupParameters) bool {
+	return p.PermanentlyRemove != nil && *p.PermanentlyRemove
+}
+
 // isGroupUpToDate checks whether there is a change in any of the modifiable fields.
 func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error) { // nolint:gocyclo
+	if g.MarkedForDeletionOn != nil {
+		return false, nil
+	}
 	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
 		return false, nil
 	}
@@ -276,8 +457,10 @@ func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error)
 	if !clients.IsIntEqualToIntPtr(p.ExtraSharedRunnersMinutesLimit, g.ExtraSharedRunnersMinutesLimit) {
 		return false, nil
 	}
-	if ok, err := isSharedWithGroupsUpToDate(p, g); err != nil || !ok {
-		return false, err
+	if p.SharedWithGroups != nil {
+		if ok, err := isSharedWithGroupsUpToDate(p, g); err != nil || !ok {
+			return false, err
+		}
 	}
 	return true, nil
 }
@@ -18,6 +18,8 @@ package groups
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -27,42 +29,61 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
 )
 
 var (
-	unexpecedItem      resource.Managed
-	path               = "path/to/group"
-	name               = "example-group"
-	displayName        = "Example Group"
-	groupAccessLevel   = 40
-	groupID            = 1234
-	groupIDtwo         = 123456
-	extName            = "1234"
-	errBoom            = errors.New("boom")
-	expiresAt          = time.Now()
-	expiresAtIso       = (gitlab.ISOTime)(expiresAt)
-	extNameAnnotation  = map[string]string{meta.AnnotationKeyExternalName: extName}
-	visibility         = "private"
-	v1alpha1Visibility = v1alpha1.VisibilityValue(visibility)
+	unexpecedItem           resource.Managed
+	path                    = "path/to/group"
+	name                    = "example-group"
+	displayName             = "Example Group"
+	groupAccessLevel        = 40
+	groupID                 = 1234
+	groupIDtwo              = 123456
+	extName                 = "1234"
+	errBoom                 = errors.New("boom")
+	expiresAt               = time.Now()
+	expiresAtIso            = (gitlab.ISOTime)(expiresAt)
+	gitlabMarkedForDeletion = gitlab.ISOTime(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	markedForDeletionOn     = metav1.NewTime(time.Time(gitlabMarkedForDeletion))
+	extNameAnnotation       = map[string]string{meta.AnnotationKeyExternalName: extName}
+	allowTransferAnnotation = map[string]string{v1alpha1.AnnotationKeyAllowTransfer: "true"}
+	visibility              = "private"
+	v1alpha1Visibility      = v1alpha1.VisibilityValue(visibility)
 
 	projectCreationLevel         = "developer"
 	v1alpha1ProjectCreationLevel = v1alpha1.ProjectCreationLevelValue(projectCreationLevel)
 
 	subGroupCreationLevel         = "maintainer"
 	v1alpha1SubGroupCreationLevel = v1alpha1.SubGroupCreationLevelValue(subGroupCreationLevel)
+
+	avatarURL   = "https://gitlab.example.com/uploads/avatar.png"
+	avatarImage = []byte("avatar")
+	avatarHash  = fmt.Sprintf("%x", sha256.Sum256(avatarImage))
 )
 
+func avatarSecret(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, secret)
+	}
+	secret.Data = map[string][]byte{"avatar.png": avatarImage}
+	return nil
+}
+
 type args struct {
 	group groups.Client
 	kube  client.Client
@@ -129,11 +150,57 @@ func withClientDefaultValues() groupModifier {
 			WebURL:    &s,
 			FullName:  &s,
 			FullPath:  &s,
+			ParentID:  &i,
 			LDAPCN:    &s,
 		}
 	}
 }
 
+func withParentID(id int) groupModifier {
+	return func(r *v1alpha1.Group) { r.Spec.ForProvider.ParentID = &id }
+}
+
+func withObservedID(id int) groupModifier {
+	return func(r *v1alpha1.Group) { r.Status.AtProvider.ID = &id }
+}
+
+func withObservedParentID(id int) groupModifier {
+	return func(r *v1alpha1.Group) { r.Status.AtProvider.ParentID = &id }
+}
+
+func withAdoptExisting(b bool) groupModifier {
+	return func(r *v1alpha1.Group) { r.Spec.ForProvider.AdoptExisting = &b }
+}
+
+func withPermanentlyRemove(b bool) groupModifier {
+	return func(r *v1alpha1.Group) { r.Spec.ForProvider.PermanentlyRemove = &b }
+}
+
+func withDeletionTimestamp() groupModifier {
+	return func(r *v1alpha1.Group) { r.SetDeletionTimestamp(&markedForDeletionOn) }
+}
+
+func withAvatar() groupModifier {
+	return func(r *v1alpha1.Group) {
+		r.Spec.ForProvider.Avatar = &common.Avatar{
+			SecretKeyRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "brand", Namespace: "default"},
+				Key:             "avatar.png",
+			},
+		}
+	}
+}
+
+func withAvatarURL(url string) groupModifier {
+	return func(r *v1alpha1.Group) { r.Status.AtProvider.AvatarURL = &url }
+}
+
+func withAvatarHash(hash string) groupModifier {
+	return func(r *v1alpha1.Group) {
+		meta.AddAnnotations(r, map[string]string{common.AnnotationKeyAvatarHash: hash})
+	}
+}
+
 func withStatus(s v1alpha1.GroupObservation) groupModifier {
 	return func(r *v1alpha1.Group) { r.Status.AtProvider = s }
 }
@@ -248,18 +315,48 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
+		"PathExternalName": {
 			args: args{
 				group: &fake.MockClient{
 					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
-						return &gitlab.Group{}, &gitlab.Response{}, nil
+						if pid != path {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+						}
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
 				},
-				cr: group(withExternalName("fr")),
+				cr: group(withExternalName(path)),
 			},
 			want: want{
-				cr:  group(withExternalName("fr")),
-				err: errors.New(errIDNotInt),
+				cr: group(withExternalName(path)),
+			},
+		},
+		"PathExternalNamePinnedToID": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if pid != path {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withPath(""), withClientDefaultValues(), withExternalName(path)),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withObservedID(groupID),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
 			},
 		},
 		"FailedGetRequest": {
@@ -290,6 +387,20 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"DeletedGroupMarkedForDeletion": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{MarkedForDeletionOn: &gitlabMarkedForDeletion}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withExternalName(extName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     group(withExternalName(extName), withDeletionTimestamp()),
+				result: managed.ExternalObservation{},
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -413,6 +524,116 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AvatarChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarSecret},
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name, AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withAvatar(),
+					withAvatarHash("outdated"),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withAvatar(),
+					withAvatarURL(avatarURL),
+					withAvatarHash("outdated"),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarUnchanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarSecret},
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name, AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withAvatar(),
+					withAvatarHash(avatarHash),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withAvatar(),
+					withAvatarURL(avatarURL),
+					withAvatarHash(avatarHash),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"UnmanagedSharedWithGroups": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{
+							Name: name,
+							SharedWithGroups: []struct {
+								GroupID          int             "json:\"group_id\""
+								GroupName        string          "json:\"group_name\""
+								GroupFullPath    string          "json:\"group_full_path\""
+								GroupAccessLevel int             "json:\"group_access_level\""
+								ExpiresAt        *gitlab.ISOTime "json:\"expires_at\""
+							}{
+								{GroupID: groupID},
+							},
+						}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withSharedWithGroupsObservation([]v1alpha1.SharedWithGroupsObservation{{
+						GroupID:          &groupID,
+						GroupName:        gitlab.String(""),
+						GroupFullPath:    gitlab.String(""),
+						GroupAccessLevel: gitlab.Int(0),
+					}}),
+					withExternalName(extName),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
 		"SuccessfulAvailable": {
 			args: args{
 				group: &fake.MockClient{
@@ -497,6 +718,10 @@ func TestObserve(t *testing.T) {
 			wantGroupModifier = append(wantGroupModifier, withDescription(&description))
 		}
 
+		if name == "ParentID" {
+			wantGroupModifier = append(wantGroupModifier, withObservedParentID(value.(int)))
+		}
+
 		gitlabGroup := &gitlab.Group{
 			Name:                  name,
 			Visibility:            gitlab.VisibilityValue(visibility),
@@ -587,6 +812,24 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{ExternalNameAssigned: true},
 			},
 		},
+		"SuccessfulCreationWithAvatar": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarSecret},
+				group: &fake.MockClient{
+					MockCreateGroup: func(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.Avatar == nil || opt.Avatar.Filename != "avatar.png" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withAvatar()),
+			},
+			want: want{
+				cr:     group(withAvatar(), withAvatarHash(avatarHash), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				group: &fake.MockClient{
@@ -601,10 +844,75 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"SuccessfulAdoption": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						switch pid {
+						case groupIDtwo:
+							return &gitlab.Group{ID: groupIDtwo, FullPath: "path/to"}, &gitlab.Response{}, nil
+						case path:
+							return &gitlab.Group{ID: groupID, FullPath: path}, &gitlab.Response{}, nil
+						}
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: group(withAdoptExisting(true), withParentID(groupIDtwo), withPath("group")),
+			},
+			want: want{
+				cr:     group(withAdoptExisting(true), withParentID(groupIDtwo), withPath("group"), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"AdoptionCreatesMissingGroup": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockCreateGroup: func(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withAdoptExisting(true), withPath("group")),
+			},
+			want: want{
+				cr:     group(withAdoptExisting(true), withPath("group"), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedParentLookup": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(withAdoptExisting(true), withParentID(groupIDtwo), withPath("group")),
+			},
+			want: want{
+				cr:  group(withAdoptExisting(true), withParentID(groupIDtwo), withPath("group")),
+				err: errors.Wrapf(errBoom, errGetParentFailed, groupIDtwo),
+			},
+		},
+		"FailedAdoptionLookup": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: group(withAdoptExisting(true), withPath("group")),
+			},
+			want: want{
+				cr:  group(withAdoptExisting(true), withPath("group")),
+				err: errors.Wrapf(errBoom, errAdoptFailed, "group"),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.group}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.group}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -640,6 +948,113 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errNotGroup),
 			},
 		},
+		"TransferNotAllowed": {
+			args: args{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withExternalName("1234"),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withExternalName("1234"),
+				),
+				err: errors.Errorf(errTransferNotAllowed, 1, 2, v1alpha1.AnnotationKeyAllowTransfer),
+			},
+		},
+		"SuccessfulTransfer": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.GroupID == nil || *opt.GroupID != 2 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Group{ID: 1234, ParentID: 2}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: 1234}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+			},
+		},
+		"SuccessfulTransferToTopLevel": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.GroupID != nil {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Group{ID: 1234}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: 1234}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withParentID(0),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(0),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+			},
+		},
+		"FailedTransfer": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withObservedParentID(1),
+					withAnnotations(allowTransferAnnotation),
+					withExternalName("1234"),
+				),
+				err: errors.Wrapf(errBoom, errTransferFailed, 2),
+			},
+		},
 		"SuccessfulUpdate": {
 			args: args{
 				group: &fake.MockClient{
@@ -656,6 +1071,48 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"AvatarChangedUpload": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarSecret, MockUpdate: test.NewMockUpdateFn(nil)},
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.Avatar == nil {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Group{ID: 1234}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withAvatarURL(avatarURL), withAvatarHash("outdated"), withExternalName("1234")),
+			},
+			want: want{
+				cr: group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withAvatarURL(avatarURL), withAvatarHash(avatarHash), withExternalName("1234")),
+			},
+		},
+		"FailedAvatarHashUpdate": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarSecret, MockUpdate: test.NewMockUpdateFn(errBoom)},
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: 1234}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withAvatarURL(avatarURL), withAvatarHash("outdated"), withExternalName("1234")),
+			},
+			want: want{
+				cr:  group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withAvatarURL(avatarURL), withAvatarHash(avatarHash), withExternalName("1234")),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"FailedAvatarRead": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withExternalName("1234")),
+			},
+			want: want{
+				cr:  group(withAvatar(), withStatus(v1alpha1.GroupObservation{ID: &groupID}), withExternalName("1234")),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get default/brand"), errAvatarFailed),
+			},
+		},
 		"SharedWithGroups": {
 			args: args{
 				group: &fake.MockClient{
@@ -803,12 +1260,69 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
+				cr: group(withSharedWithGroups([]v1alpha1.SharedWithGroups{})),
+			},
+			want: want{
+				cr:     group(withSharedWithGroups([]v1alpha1.SharedWithGroups{})),
+				result: managed.ExternalUpdate{},
+				err:    errors.Wrapf(errBoom, errUnshareFailed, groupID),
+			},
+		},
+		"UnmanagedSharesKept": {
+			args: args{
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{
+							SharedWithGroups: []struct {
+								GroupID          int             "json:\"group_id\""
+								GroupName        string          "json:\"group_name\""
+								GroupFullPath    string          "json:\"group_full_path\""
+								GroupAccessLevel int             "json:\"group_access_level\""
+								ExpiresAt        *gitlab.ISOTime "json:\"expires_at\""
+							}{
+								{GroupID: groupID},
+							},
+						}, nil, nil
+					},
+					MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, errBoom
+					},
+				},
 				cr: group(),
 			},
 			want: want{
 				cr:     group(),
 				result: managed.ExternalUpdate{},
-				err:    errors.Wrapf(errBoom, errUnshareFailed, groupID),
+			},
+		},
+		"SuccessfulRestore": {
+			args: args{
+				group: &fake.MockClient{
+					MockRestoreGroup: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(withStatus(v1alpha1.GroupObservation{MarkedForDeletionOn: &markedForDeletionOn})),
+			},
+			want: want{
+				cr: group(withStatus(v1alpha1.GroupObservation{MarkedForDeletionOn: &markedForDeletionOn})),
+			},
+		},
+		"FailedRestore": {
+			args: args{
+				group: &fake.MockClient{
+					MockRestoreGroup: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(withStatus(v1alpha1.GroupObservation{MarkedForDeletionOn: &markedForDeletionOn})),
+			},
+			want: want{
+				cr:  group(withStatus(v1alpha1.GroupObservation{MarkedForDeletionOn: &markedForDeletionOn})),
+				err: errors.Wrap(errBoom, errRestoreFailed),
 			},
 		},
 		"FailedUpdate": {
@@ -828,7 +1342,7 @@ func TestUpdate(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.group}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.group}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -877,6 +1391,67 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulPermanentRemoval": {
+			args: args{
+				group: &fake.MockClient{
+					MockDeleteGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if len(options) != 2 {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+			},
+			want: want{
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+			},
+		},
+		"PermanentRemovalNotFound": {
+			args: args{
+				group: &fake.MockClient{
+					MockDeleteGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+			},
+			want: want{
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+			},
+		},
+		"FailedPermanentRemoval": {
+			args: args{
+				group: &fake.MockClient{
+					MockDeleteGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+			},
+			want: want{
+				cr: group(
+					withPermanentlyRemove(true),
+					withStatus(v1alpha1.GroupObservation{FullPath: &path, MarkedForDeletionOn: &markedForDeletionOn}),
+				),
+				err: errors.Wrap(errBoom, errRemoveFailed),
+			},
+		},
 		"FailedDeletion": {
 			args: args{
 				group: &fake.MockClient{
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupshares
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+)
+
+const (
+	errNotGroupShare            = "managed resource is not a Gitlab Group Share custom resource"
+	errCreateFailed             = "cannot share Gitlab Group"
+	errUpdateFailed             = "cannot re-share Gitlab Group"
+	errUnshareFailed            = "cannot unshare Gitlab Group to re-share it"
+	errDeleteFailed             = "cannot unshare Gitlab Group"
+	errGetFailed                = "cannot get Gitlab Group"
+	errMissingGroupID           = "Group ID not set"
+	errMissingSharedWithGroupID = "SharedWithGroup ID not set"
+)
+
+// SetupGroupShare adds a controller that reconciles Group Shares.
+func SetupGroupShare(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupShareKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.GroupShare{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GroupShareGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return nil, errors.New(errNotGroupShare)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupShare)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.SharedWithGroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errMissingSharedWithGroupID)
+	}
+
+	grp, res, err := e.client.GetGroup(*cr.Spec.ForProvider.GroupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	o, found := groups.GenerateGroupShareObservation(grp, *cr.Spec.ForProvider.SharedWithGroupID)
+	if !found {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = o
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isGroupShareUpToDate(&cr.Spec.ForProvider, &o),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupShare)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingGroupID)
+	}
+
+	_, _, err := e.client.ShareGroupWithGroup(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateShareGroupWithGroupOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+// Update re-creates the share, since GitLab offers no API to modify the
+// access level or expiry of an existing group share and rejects sharing with
+// an already linked group.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupShare)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.SharedWithGroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingSharedWithGroupID)
+	}
+
+	res, err := e.client.UnshareGroupFromGroup(
+		*cr.Spec.ForProvider.GroupID,
+		*cr.Spec.ForProvider.SharedWithGroupID,
+		gitlab.WithContext(ctx),
+	)
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUnshareFailed)
+	}
+
+	_, _, err = e.client.ShareGroupWithGroup(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateShareGroupWithGroupOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GroupShare)
+	if !ok {
+		return errors.New(errNotGroupShare)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return errors.New(errMissingGroupID)
+	}
+	if cr.Spec.ForProvider.SharedWithGroupID == nil {
+		return errors.New(errMissingSharedWithGroupID)
+	}
+
+	_, err := e.client.UnshareGroupFromGroup(
+		*cr.Spec.ForProvider.GroupID,
+		*cr.Spec.ForProvider.SharedWithGroupID,
+		gitlab.WithContext(ctx),
+	)
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// isGroupShareUpToDate checks whether the access level or expiry of the share
+// has drifted from the desired state.
+func isGroupShareUpToDate(p *v1alpha1.GroupShareParameters, o *v1alpha1.GroupShareObservation) bool {
+	if !cmp.Equal(int(p.GroupAccessLevel), o.GroupAccessLevel) {
+		return false
+	}
+
+	if !cmp.Equal(formatDate(p.ExpiresAt), formatDate(o.ExpiresAt)) {
+		return false
+	}
+
+	return true
+}
+
+// formatDate returns the date part of t in ISO 8601 format, or an empty
+// string if t is not set. GitLab stores share expiry with day precision.
+func formatDate(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,558 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupshares
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	unexpecedItem     resource.Managed
+	errBoom           = errors.New("boom")
+	groupID           = 1234
+	sharedWithGroupID = 5678
+	groupName         = "shared"
+	groupFullPath     = "parent/shared"
+	expiresAt         = time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	expiresAtNew      = time.Date(2030, 2, 3, 0, 0, 0, 0, time.UTC)
+)
+
+type args struct {
+	group groups.Client
+	kube  client.Client
+	cr    resource.Managed
+}
+
+type groupShareModifier func(*v1alpha1.GroupShare)
+
+func withConditions(c ...xpv1.Condition) groupShareModifier {
+	return func(cr *v1alpha1.GroupShare) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withIDs() groupShareModifier {
+	return func(r *v1alpha1.GroupShare) {
+		r.Spec.ForProvider.GroupID = &groupID
+		r.Spec.ForProvider.SharedWithGroupID = &sharedWithGroupID
+	}
+}
+
+func withAccessLevel(i int) groupShareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.GroupAccessLevel = v1alpha1.AccessLevelValue(i) }
+}
+
+func withExpiresAt(t time.Time) groupShareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Spec.ForProvider.ExpiresAt = &metav1.Time{Time: t} }
+}
+
+func withStatus(s v1alpha1.GroupShareObservation) groupShareModifier {
+	return func(r *v1alpha1.GroupShare) { r.Status.AtProvider = s }
+}
+
+func groupShare(m ...groupShareModifier) *v1alpha1.GroupShare {
+	cr := &v1alpha1.GroupShare{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func sharedGroup(accessLevel int, expires *time.Time) *gitlab.Group {
+	grp := &gitlab.Group{ID: groupID}
+	sh := struct {
+		GroupID          int             `json:"group_id"`
+		GroupName        string          `json:"group_name"`
+		GroupFullPath    string          `json:"group_full_path"`
+		GroupAccessLevel int             `json:"group_access_level"`
+		ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+	}{
+		GroupID:          sharedWithGroupID,
+		GroupName:        groupName,
+		GroupFullPath:    groupFullPath,
+		GroupAccessLevel: accessLevel,
+	}
+	if expires != nil {
+		sh.ExpiresAt = (*gitlab.ISOTime)(expires)
+	}
+	grp.SharedWithGroups = append(grp.SharedWithGroups, sh)
+	return grp
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalClient
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotGroupShare),
+			},
+		},
+		"ProviderConfigRefNotGivenError": {
+			args: args{
+				cr:   groupShare(),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+			want: want{
+				cr:  groupShare(),
+				err: errors.New("providerConfigRef is not given"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newGitlabClientFn: nil}
+			o, err := c.Connect(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotGroupShare),
+			},
+		},
+		"NoGroupID": {
+			args: args{
+				cr: groupShare(),
+			},
+			want: want{
+				cr:  groupShare(),
+				err: errors.New(errMissingGroupID),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: groupShare(withIDs()),
+			},
+			want: want{
+				cr:  groupShare(withIDs()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"GroupNotFound": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: groupShare(withIDs()),
+			},
+			want: want{
+				cr:     groupShare(withIDs()),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ShareNotFound": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs()),
+			},
+			want: want{
+				cr:     groupShare(withIDs()),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return sharedGroup(30, &expiresAt), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(30), withExpiresAt(expiresAt)),
+			},
+			want: want{
+				cr: groupShare(
+					withIDs(),
+					withAccessLevel(30),
+					withExpiresAt(expiresAt),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupShareObservation{
+						GroupName:        groupName,
+						GroupFullPath:    groupFullPath,
+						GroupAccessLevel: 30,
+						ExpiresAt:        &metav1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AccessLevelDrift": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return sharedGroup(20, nil), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(30)),
+			},
+			want: want{
+				cr: groupShare(
+					withIDs(),
+					withAccessLevel(30),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupShareObservation{
+						GroupName:        groupName,
+						GroupFullPath:    groupFullPath,
+						GroupAccessLevel: 20,
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ExpiresAtDrift": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return sharedGroup(30, &expiresAt), &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(30), withExpiresAt(expiresAtNew)),
+			},
+			want: want{
+				cr: groupShare(
+					withIDs(),
+					withAccessLevel(30),
+					withExpiresAt(expiresAtNew),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.GroupShareObservation{
+						GroupName:        groupName,
+						GroupFullPath:    groupFullPath,
+						GroupAccessLevel: 30,
+						ExpiresAt:        &metav1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.group}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotGroupShare),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				group: &fake.MockClient{
+					MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(30)),
+			},
+			want: want{
+				cr: groupShare(withIDs(), withAccessLevel(30)),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				group: &fake.MockClient{
+					MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(30)),
+			},
+			want: want{
+				cr:  groupShare(withIDs(), withAccessLevel(30)),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.group}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// recordCalls returns a client that records the share calls made through it
+// in calls. Unsharing returns res and err.
+func recordCalls(calls *[]string, res *gitlab.Response, err error) *fake.MockClient {
+	return &fake.MockClient{
+		MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			*calls = append(*calls, "unshare")
+			return res, err
+		},
+		MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+			*calls = append(*calls, "share")
+			return &gitlab.Group{}, &gitlab.Response{}, nil
+		},
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+		calls  []string
+	}
+
+	var calls []string
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotGroupShare),
+			},
+		},
+		"SuccessfulReshare": {
+			args: args{
+				group: recordCalls(&calls, nil, nil),
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+			},
+			want: want{
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+				calls: []string{"unshare", "share"},
+			},
+		},
+		"ShareAlreadyGone": {
+			args: args{
+				group: recordCalls(&calls, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom),
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+			},
+			want: want{
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+				calls: []string{"unshare", "share"},
+			},
+		},
+		"FailedUnshare": {
+			args: args{
+				group: recordCalls(&calls, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom),
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+			},
+			want: want{
+				cr:    groupShare(withIDs(), withAccessLevel(40)),
+				err:   errors.Wrap(errBoom, errUnshareFailed),
+				calls: []string{"unshare"},
+			},
+		},
+		"FailedShare": {
+			args: args{
+				group: &fake.MockClient{
+					MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+					MockShareGroupWithGroup: func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: groupShare(withIDs(), withAccessLevel(40)),
+			},
+			want: want{
+				cr:  groupShare(withIDs(), withAccessLevel(40)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls = nil
+			e := &external{kube: tc.kube, client: tc.group}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotGroupShare),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				group: &fake.MockClient{
+					MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: groupShare(withIDs()),
+			},
+			want: want{
+				cr: groupShare(withIDs()),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				group: &fake.MockClient{
+					MockUnshareGroupFromGroup: func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: groupShare(withIDs()),
+			},
+			want: want{
+				cr:  groupShare(withIDs()),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.group}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	branchName   = "release/1.0"
+	commitSHA    = "0b4bc9a49b562e85de7cc9e834518ea6828729b9"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.BranchClient
+	cr     resource.Managed
+}
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withConditions(c ...xpv1.Condition) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withStatus(s v1alpha1.BranchObservation) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.AtProvider = s }
+}
+
+func branch(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{}
+	cr.Spec.ForProvider.Name = branchName
+	cr.Spec.ForProvider.Ref = "main"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr: branch(withProjectID(projectID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return &gitlab.Branch{Name: branch, Commit: &gitlab.Commit{ID: commitSHA}, Protected: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr: branch(
+					withProjectID(projectID),
+					withStatus(v1alpha1.BranchObservation{CommitSHA: commitSHA, Protected: true}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateBranch: func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						if *opt.Branch != branchName || *opt.Ref != "main" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Branch{Name: branchName}, &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr: branch(withProjectID(projectID), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateBranch: func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotBranch),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr: branch(withProjectID(projectID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  branch(withProjectID(projectID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
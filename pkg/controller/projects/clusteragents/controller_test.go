@@ -0,0 +1,331 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragents
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = 1234
+	agentID      = 42
+	agentName    = "production"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.ClusterAgentClient
+	cr     resource.Managed
+}
+
+type clusterAgentModifier func(*v1alpha1.ClusterAgent)
+
+func withConditions(c ...xpv1.Condition) clusterAgentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id int) clusterAgentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withExternalName(n string) clusterAgentModifier {
+	return func(r *v1alpha1.ClusterAgent) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ClusterAgentObservation) clusterAgentModifier {
+	return func(r *v1alpha1.ClusterAgent) { r.Status.AtProvider = s }
+}
+
+func clusterAgent(m ...clusterAgentModifier) *v1alpha1.ClusterAgent {
+	cr := &v1alpha1.ClusterAgent{}
+	cr.Spec.ForProvider.Name = agentName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgent),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: clusterAgent(withProjectID(projectID)),
+			},
+			want: want{
+				cr: clusterAgent(withProjectID(projectID)),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: clusterAgent(withProjectID(projectID), withExternalName("fr")),
+			},
+			want: want{
+				cr:  clusterAgent(withProjectID(projectID), withExternalName("fr")),
+				err: errors.New(errIDnotInt),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: clusterAgent(withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgent(withExternalName("42")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgent(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+						return &gitlab.Agent{ID: id, Name: agentName, CreatedByUserID: 1, ConfigProject: gitlab.ConfigProject{PathWithNamespace: "example/project"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgent(
+					withProjectID(projectID),
+					withExternalName("42"),
+					withStatus(v1alpha1.ClusterAgentObservation{CreatedByUserID: 1, ConfigProjectPathWithNamespace: "example/project"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgent),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: clusterAgent(),
+			},
+			want: want{
+				cr:  clusterAgent(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockRegisterAgent: func(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+						if pid != projectID || *opt.Name != agentName {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Agent{ID: agentID, Name: agentName}, &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID)),
+			},
+			want: want{
+				cr:     clusterAgent(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockRegisterAgent: func(pid interface{}, opt *gitlab.RegisterAgentOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Agent, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  clusterAgent(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgent),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAgent: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: clusterAgent(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgent(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragenttokens
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotClusterAgentToken = "managed resource is not a Gitlab cluster agent token custom resource"
+	errIDnotInt             = "ID is not an integer"
+	errGetFailed            = "cannot get Gitlab cluster agent token"
+	errCreateFailed         = "cannot create Gitlab cluster agent token"
+	errRevokeFailed         = "cannot revoke Gitlab cluster agent token"
+	errProjectIDMissing     = "ProjectID is missing"
+	errAgentIDMissing       = "AgentID is missing"
+)
+
+// SetupClusterAgentToken adds a controller that reconciles ClusterAgentTokens.
+func SetupClusterAgentToken(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterAgentTokenKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ClusterAgentToken{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterAgentTokenGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewClusterAgentTokenClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ClusterAgentTokenClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgentToken)
+	if !ok {
+		return nil, errors.New(errNotClusterAgentToken)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ClusterAgentTokenClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgentToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterAgentToken)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.Atoi(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDnotInt)
+	}
+
+	if err := validate(cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	t, res, err := e.client.GetAgentToken(*cr.Spec.ForProvider.ProjectID, *cr.Spec.ForProvider.AgentID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// A revoked token cannot be used anymore, so a new one is created in its
+	// place.
+	if t.Status == projects.AgentTokenStatusRevoked {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = projects.GenerateClusterAgentTokenObservation(t)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAgentToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterAgentToken)
+	}
+	if err := validate(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	t, _, err := e.client.CreateAgentToken(
+		*cr.Spec.ForProvider.ProjectID,
+		*cr.Spec.ForProvider.AgentID,
+		projects.GenerateCreateAgentTokenOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	connectionDetails := managed.ConnectionDetails{}
+	connectionDetails["token"] = []byte(t.Token)
+
+	meta.SetExternalName(cr, strconv.Itoa(t.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connectionDetails}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// it's not possible to update an agent token
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterAgentToken)
+	if !ok {
+		return errors.New(errNotClusterAgentToken)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDnotInt)
+	}
+
+	if err := validate(cr); err != nil {
+		return err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err = e.client.RevokeAgentToken(*cr.Spec.ForProvider.ProjectID, *cr.Spec.ForProvider.AgentID, id, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errRevokeFailed)
+}
+
+func validate(cr *v1alpha1.ClusterAgentToken) error {
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+	if cr.Spec.ForProvider.AgentID == nil {
+		return errors.New(errAgentIDMissing)
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragenttokens
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = 1234
+	agentID      = 7
+	tokenID      = 42
+	tokenName    = "production"
+	token        = "glagent-0123456789abcdef"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.ClusterAgentTokenClient
+	cr     resource.Managed
+}
+
+type clusterAgentTokenModifier func(*v1alpha1.ClusterAgentToken)
+
+func withConditions(c ...xpv1.Condition) clusterAgentTokenModifier {
+	return func(r *v1alpha1.ClusterAgentToken) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id int) clusterAgentTokenModifier {
+	return func(r *v1alpha1.ClusterAgentToken) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withAgentID(id int) clusterAgentTokenModifier {
+	return func(r *v1alpha1.ClusterAgentToken) { r.Spec.ForProvider.AgentID = &id }
+}
+
+func withExternalName(n string) clusterAgentTokenModifier {
+	return func(r *v1alpha1.ClusterAgentToken) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.ClusterAgentTokenObservation) clusterAgentTokenModifier {
+	return func(r *v1alpha1.ClusterAgentToken) { r.Status.AtProvider = s }
+}
+
+func clusterAgentToken(m ...clusterAgentTokenModifier) *v1alpha1.ClusterAgentToken {
+	cr := &v1alpha1.ClusterAgentToken{}
+	cr.Spec.ForProvider.Name = tokenName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgentToken),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID)),
+			},
+			want: want{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID)),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("fr")),
+			},
+			want: want{
+				cr:  clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("fr")),
+				err: errors.New(errIDnotInt),
+			},
+		},
+		"MissingAgentID": {
+			args: args{
+				cr: clusterAgentToken(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgentToken(withProjectID(projectID), withExternalName("42")),
+				err: errors.New(errAgentIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Revoked": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						return &gitlab.AgentToken{ID: id, Status: "revoked"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						if aid != agentID {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.AgentToken{ID: id, Name: tokenName, Status: "active", CreatedByUserID: 1}, &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgentToken(
+					withProjectID(projectID),
+					withAgentID(agentID),
+					withExternalName("42"),
+					withStatus(v1alpha1.ClusterAgentTokenObservation{Status: "active", CreatedByUserID: 1}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgentToken),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: clusterAgentToken(withAgentID(agentID)),
+			},
+			want: want{
+				cr:  clusterAgentToken(withAgentID(agentID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAgentToken: func(pid interface{}, aid int, opt *gitlab.CreateAgentTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						if pid != projectID || aid != agentID || *opt.Name != tokenName {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.AgentToken{ID: tokenID, Token: token}, &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID)),
+			},
+			want: want{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAgentToken: func(pid interface{}, aid int, opt *gitlab.CreateAgentTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.AgentToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID)),
+			},
+			want: want{
+				cr:  clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotClusterAgentToken),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if aid != agentID || id != tokenID {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeAgentToken: func(pid interface{}, aid int, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42")),
+			},
+			want: want{
+				cr:  clusterAgentToken(withProjectID(projectID), withAgentID(agentID), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errRevokeFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exports
+
+import (
+	"context"
+	"os"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotProjectExport = "managed resource is not a Gitlab project export custom resource"
+	errGetFailed        = "cannot get Gitlab project export status"
+	errCreateFailed     = "cannot schedule Gitlab project export"
+	errExportFailed     = "Gitlab project export failed: %s"
+	errUploadURLMissing = "cannot read upload URL from secret"
+	errMissingProjectID = "missing Spec.ForProvider.ProjectID"
+	errDestination      = "exactly one of Spec.ForProvider.UploadURLSecretRef and Spec.ForProvider.DownloadPath must be set"
+	errDownloadFailed   = "cannot download Gitlab project export"
+	errWriteFailed      = "cannot write Gitlab project export to %s"
+)
+
+// Export states reported by GitLab.
+const (
+	exportStatusNone     = "none"
+	exportStatusFinished = "finished"
+	exportStatusFailed   = "failed"
+)
+
+// SetupProjectExport adds a controller that reconciles ProjectExports.
+func SetupProjectExport(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectExportKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProjectExport{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectExportGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewImportExportClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ImportExportClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExport)
+	if !ok {
+		return nil, errors.New(errNotProjectExport)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ImportExportClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectExport)
+	}
+
+	// Exports are removed by GitLab on its own, there is nothing to wait for
+	// once the resource is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// The external name is the ID of the exported project, which only ever
+	// has a single export.
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	st, res, err := e.client.ExportStatus(externalName, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// GitLab eventually removes finished exports. The archive has been
+	// uploaded by then, so only an export that never finished is redone.
+	if st.ExportStatus == exportStatusNone {
+		if cr.Status.AtProvider.ExportStatus != exportStatusFinished {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	cr.Status.AtProvider = projects.GenerateProjectExportObservation(st)
+	upToDate := true
+	switch st.ExportStatus {
+	case exportStatusFinished:
+		cr.Status.SetConditions(xpv1.Available())
+		// A finished export is downloaded by Update.
+		upToDate = isDownloaded(cr.Spec.ForProvider.DownloadPath)
+	case exportStatusFailed:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(errors.Errorf(errExportFailed, st.Message).Error()))
+	default:
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectExport)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingProjectID)
+	}
+	if (cr.Spec.ForProvider.UploadURLSecretRef == nil) == (cr.Spec.ForProvider.DownloadPath == nil) {
+		return managed.ExternalCreation{}, errors.New(errDestination)
+	}
+
+	uploadURL := ""
+	if ref := cr.Spec.ForProvider.UploadURLSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errUploadURLMissing)
+		}
+		uploadURL = string(secret.Data[ref.Key])
+	}
+
+	_, err := e.client.ScheduleExport(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateScheduleExportOptions(&cr.Spec.ForProvider, uploadURL),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update writes the archive of a finished export to
+// spec.forProvider.downloadPath. The archive is streamed to a temporary file
+// first, so that an interrupted download is retried.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectExport)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectExport)
+	}
+
+	path := cr.Spec.ForProvider.DownloadPath
+	if path == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	tmp := *path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errWriteFailed, *path)
+	}
+	_, err = e.client.DownloadExport(meta.GetExternalName(cr), f, gitlab.WithContext(ctx))
+	if cerr := f.Close(); err == nil && cerr != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(cerr, errWriteFailed, *path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDownloadFailed)
+	}
+	return managed.ExternalUpdate{}, errors.Wrapf(os.Rename(tmp, *path), errWriteFailed, *path)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	// GitLab removes exports on its own and the archive is not managed by the
+	// provider once uploaded or downloaded, so there is nothing to delete.
+	return nil
+}
+
+// isDownloaded reports whether the archive has been written to path, or
+// needs no download at all.
+func isDownloaded(path *string) bool {
+	if path == nil {
+		return true
+	}
+	_, err := os.Stat(*path)
+	return err == nil
+}
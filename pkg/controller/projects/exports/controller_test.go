@@ -0,0 +1,523 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	uploadURL    = "https://bucket.example.com/export.tar.gz?signature=abc"
+	invalidInput resource.Managed
+	deletedAt    = metav1.Now()
+	secretRef    = xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "export", Namespace: "crossplane-system"},
+		Key:             "url",
+	}
+)
+
+type args struct {
+	kube   client.Client
+	client projects.ImportExportClient
+	cr     resource.Managed
+}
+
+type projectExportModifier func(*v1alpha1.ProjectExport)
+
+func withConditions(c ...xpv1.Condition) projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { meta.SetExternalName(r, n) }
+}
+
+func withProjectID(id string) projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withStatus(s v1alpha1.ProjectExportObservation) projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.Status.AtProvider = s }
+}
+
+func withDownloadPath(p string) projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.Spec.ForProvider.DownloadPath = &p }
+}
+
+func withoutUploadURL() projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.Spec.ForProvider.UploadURLSecretRef = nil }
+}
+
+func withDeletionTimestamp() projectExportModifier {
+	return func(r *v1alpha1.ProjectExport) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func projectExport(m ...projectExportModifier) *v1alpha1.ProjectExport {
+	cr := &v1alpha1.ProjectExport{}
+	ref := secretRef
+	cr.Spec.ForProvider.UploadURLSecretRef = &ref
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func exportStatus(status string) func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+	return func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+		return &gitlab.ExportStatus{ExportStatus: status, Message: status}, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	dir := t.TempDir()
+	downloaded := filepath.Join(dir, "downloaded.tar.gz")
+	if err := os.WriteFile(downloaded, []byte("archive"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.tar.gz")
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotProjectExport),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("finished")},
+				cr:     projectExport(withExternalName(projectID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: projectExport(withExternalName(projectID), withDeletionTimestamp()),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: projectExport(),
+			},
+			want: want{
+				cr: projectExport(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockExportStatus: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: projectExport(withExternalName(projectID)),
+			},
+			want: want{
+				cr: projectExport(withExternalName(projectID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockExportStatus: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ExportStatus, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: projectExport(withExternalName(projectID)),
+			},
+			want: want{
+				cr:  projectExport(withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Started": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("started")},
+				cr:     projectExport(withExternalName(projectID)),
+			},
+			want: want{
+				cr: projectExport(
+					withExternalName(projectID),
+					withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "started", Message: "started"}),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Finished": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("finished")},
+				cr:     projectExport(withExternalName(projectID)),
+			},
+			want: want{
+				cr: projectExport(
+					withExternalName(projectID),
+					withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "finished", Message: "finished"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FinishedNotDownloaded": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("finished")},
+				cr:     projectExport(withExternalName(projectID), withoutUploadURL(), withDownloadPath(missing)),
+			},
+			want: want{
+				cr: projectExport(
+					withExternalName(projectID),
+					withoutUploadURL(),
+					withDownloadPath(missing),
+					withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "finished", Message: "finished"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"FinishedDownloaded": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("finished")},
+				cr:     projectExport(withExternalName(projectID), withoutUploadURL(), withDownloadPath(downloaded)),
+			},
+			want: want{
+				cr: projectExport(
+					withExternalName(projectID),
+					withoutUploadURL(),
+					withDownloadPath(downloaded),
+					withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "finished", Message: "finished"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("failed")},
+				cr:     projectExport(withExternalName(projectID)),
+			},
+			want: want{
+				cr: projectExport(
+					withExternalName(projectID),
+					withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "failed", Message: "failed"}),
+					withConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errExportFailed, "failed"))),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NoneBeforeFinished": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("none")},
+				cr:     projectExport(withExternalName(projectID), withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "started"})),
+			},
+			want: want{
+				cr:     projectExport(withExternalName(projectID), withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "started"})),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"NoneAfterFinished": {
+			args: args{
+				client: &fake.MockClient{MockExportStatus: exportStatus("none")},
+				cr:     projectExport(withExternalName(projectID), withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "finished"})),
+			},
+			want: want{
+				cr:     projectExport(withExternalName(projectID), withStatus(v1alpha1.ProjectExportObservation{ExportStatus: "finished"})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	secretWithURL := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, secret)
+		}
+		secret.Data = map[string][]byte{"url": []byte(uploadURL)}
+		return nil
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotProjectExport),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: projectExport(),
+			},
+			want: want{
+				cr:  projectExport(),
+				err: errors.New(errMissingProjectID),
+			},
+		},
+		"MissingDestination": {
+			args: args{
+				cr: projectExport(withProjectID(projectID), withoutUploadURL()),
+			},
+			want: want{
+				cr:  projectExport(withProjectID(projectID), withoutUploadURL()),
+				err: errors.New(errDestination),
+			},
+		},
+		"BothDestinations": {
+			args: args{
+				cr: projectExport(withProjectID(projectID), withDownloadPath("/exports/project.tar.gz")),
+			},
+			want: want{
+				cr:  projectExport(withProjectID(projectID), withDownloadPath("/exports/project.tar.gz")),
+				err: errors.New(errDestination),
+			},
+		},
+		"FailedSecretRead": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   projectExport(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  projectExport(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errUploadURLMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				kube: &test.MockClient{MockGet: secretWithURL},
+				client: &fake.MockClient{
+					MockScheduleExport: func(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if *opt.Upload.URL != uploadURL {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: projectExport(withProjectID(projectID)),
+			},
+			want: want{
+				cr: projectExport(
+					withProjectID(projectID),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"SuccessfulDownloadCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockScheduleExport: func(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if opt.Upload.URL != nil {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: projectExport(withProjectID(projectID), withoutUploadURL(), withDownloadPath("/exports/project.tar.gz")),
+			},
+			want: want{
+				cr: projectExport(
+					withProjectID(projectID),
+					withoutUploadURL(),
+					withDownloadPath("/exports/project.tar.gz"),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				kube: &test.MockClient{MockGet: secretWithURL},
+				client: &fake.MockClient{
+					MockScheduleExport: func(pid interface{}, opt *gitlab.ScheduleExportOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectExport(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  projectExport(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "project.tar.gz")
+
+	type want struct {
+		archive string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				err: errors.New(errNotProjectExport),
+			},
+		},
+		"Upload": {
+			args: args{
+				cr: projectExport(withExternalName(projectID)),
+			},
+		},
+		"SuccessfulDownload": {
+			args: args{
+				client: &fake.MockClient{
+					MockDownloadExport: func(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if pid != projectID {
+							return &gitlab.Response{}, errBoom
+						}
+						_, err := io.WriteString(w, "archive")
+						return &gitlab.Response{}, err
+					},
+				},
+				cr: projectExport(withExternalName(projectID), withoutUploadURL(), withDownloadPath(path)),
+			},
+			want: want{
+				archive: "archive",
+			},
+		},
+		"FailedDownload": {
+			args: args{
+				client: &fake.MockClient{
+					MockDownloadExport: func(pid interface{}, w io.Writer, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectExport(withExternalName(projectID), withoutUploadURL(), withDownloadPath(filepath.Join(dir, "failed.tar.gz"))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDownloadFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.archive == "" {
+				return
+			}
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.archive, string(got)); diff != "" {
+				t.Errorf("archive: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	e := &external{}
+	if err := e.Delete(context.Background(), projectExport(withExternalName(projectID), withDeletionTimestamp())); err != nil {
+		t.Errorf("Delete(...): %v", err)
+	}
+}
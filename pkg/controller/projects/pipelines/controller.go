@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotPipeline      = "managed resource is not a Gitlab pipeline custom resource"
+	errIDnotInt         = "ID is not an integer"
+	errGetFailed        = "cannot get Gitlab pipeline"
+	errListJobsFailed   = "cannot list jobs of Gitlab pipeline"
+	errCreateFailed     = "cannot create Gitlab pipeline"
+	errDeleteFailed     = "cannot delete Gitlab pipeline"
+	errProjectIDMissing = "ProjectID is missing"
+	msgPipelineStatus   = "pipeline status is %s"
+)
+
+// SetupPipeline adds a controller that reconciles Pipelines.
+func SetupPipeline(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PipelineKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Pipeline{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PipelineGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewPipelineClient, newJobClientFn: projects.NewPipelineJobClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.PipelineClient
+	newJobClientFn    func(cfg clients.Config) projects.PipelineJobClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Pipeline)
+	if !ok {
+		return nil, errors.New(errNotPipeline)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:      c.kube,
+		client:    c.newGitlabClientFn(*cfg),
+		jobClient: c.newJobClientFn(*cfg),
+	}, nil
+}
+
+type external struct {
+	kube      client.Client
+	client    projects.PipelineClient
+	jobClient projects.PipelineJobClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Pipeline)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPipeline)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.Atoi(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDnotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	p, res, err := e.client.GetPipeline(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	jobs, err := e.jobs(ctx, *cr.Spec.ForProvider.ProjectID, id)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = projects.GeneratePipelineObservation(p, jobs)
+
+	// Only a passed pipeline makes the resource ready, so that resources
+	// depending on it wait for it to succeed.
+	if p.Status == projects.PipelineStatusSuccess {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(msgPipelineStatus, p.Status)))
+	}
+
+	// A pipeline runs only once, there is nothing to update.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Pipeline)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPipeline)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	vars, err := projects.ResolvePipelineVariables(ctx, e.kube, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	params := cr.Spec.ForProvider.DeepCopy()
+	params.Variables = vars
+
+	cr.Status.SetConditions(xpv1.Creating())
+	p, _, err := e.client.CreatePipeline(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreatePipelineOptions(params),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(p.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// it's not possible to update a pipeline
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Pipeline)
+	if !ok {
+		return errors.New(errNotPipeline)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDnotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err = e.client.DeletePipeline(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// jobs returns all jobs of the pipeline with the given ID.
+func (e *external) jobs(ctx context.Context, pid string, id int) ([]*gitlab.Job, error) {
+	var all []*gitlab.Job
+	opt := &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		jobs, res, err := e.jobClient.ListPipelineJobs(pid, id, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListJobsFailed)
+		}
+		all = append(all, jobs...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
@@ -0,0 +1,416 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelines
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	pipelineID   = 42
+	webURL       = "https://gitlab.example.com/group/project/-/pipelines/42"
+	invalidInput resource.Managed
+
+	secretVariable = v1alpha1.PipelineVariable{
+		Key:            "PASSWORD",
+		ValueSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "registry", Namespace: "crossplane-system"}, Key: "password"},
+	}
+)
+
+type args struct {
+	kube      client.Client
+	client    projects.PipelineClient
+	jobClient projects.PipelineJobClient
+	cr        resource.Managed
+}
+
+type pipelineModifier func(*v1alpha1.Pipeline)
+
+func withConditions(c ...xpv1.Condition) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id string) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withExternalName(n string) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { meta.SetExternalName(r, n) }
+}
+
+func withVariables(v ...v1alpha1.PipelineVariable) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Spec.ForProvider.Variables = v }
+}
+
+func withStatus(s v1alpha1.PipelineObservation) pipelineModifier {
+	return func(r *v1alpha1.Pipeline) { r.Status.AtProvider = s }
+}
+
+func pipeline(m ...pipelineModifier) *v1alpha1.Pipeline {
+	cr := &v1alpha1.Pipeline{}
+	cr.Spec.ForProvider.Ref = "main"
+	cr.Spec.ForProvider.Variables = []v1alpha1.PipelineVariable{{Key: "IMAGE", Value: "registry.example.com/app"}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPipeline(status string) func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+	return func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+		return &gitlab.Pipeline{ID: pipeline, Status: status, WebURL: webURL}, &gitlab.Response{}, nil
+	}
+}
+
+func listPipelineJobs(status string) func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+	return func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+		return []*gitlab.Job{{ID: 7, Name: "seed", Stage: "deploy", Status: status}}, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipeline),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: pipeline(withProjectID(projectID)),
+			},
+			want: want{
+				cr: pipeline(withProjectID(projectID)),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: pipeline(withProjectID(projectID), withExternalName("fr")),
+			},
+			want: want{
+				cr:  pipeline(withProjectID(projectID), withExternalName("fr")),
+				err: errors.New(errIDnotInt),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: pipeline(withExternalName("42")),
+			},
+			want: want{
+				cr:  pipeline(withExternalName("42")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipeline(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FailedListJobs": {
+			args: args{
+				client: &fake.MockClient{MockGetPipeline: getPipeline("running")},
+				jobClient: &fake.MockClient{
+					MockListPipelineJobs: func(pid interface{}, pipelineID int, opts *gitlab.ListJobsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipeline(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errListJobsFailed),
+			},
+		},
+		"Running": {
+			args: args{
+				client:    &fake.MockClient{MockGetPipeline: getPipeline("running")},
+				jobClient: &fake.MockClient{MockListPipelineJobs: listPipelineJobs("running")},
+				cr:        pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipeline(
+					withProjectID(projectID),
+					withExternalName("42"),
+					withStatus(v1alpha1.PipelineObservation{
+						ID:     pipelineID,
+						Status: "running",
+						WebURL: webURL,
+						Jobs:   []v1alpha1.PipelineJob{{ID: 7, Name: "seed", Stage: "deploy", Status: "running"}},
+					}),
+					withConditions(xpv1.Unavailable().WithMessage("pipeline status is running")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Success": {
+			args: args{
+				client:    &fake.MockClient{MockGetPipeline: getPipeline("success")},
+				jobClient: &fake.MockClient{MockListPipelineJobs: listPipelineJobs("success")},
+				cr:        pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipeline(
+					withProjectID(projectID),
+					withExternalName("42"),
+					withStatus(v1alpha1.PipelineObservation{
+						ID:     pipelineID,
+						Status: "success",
+						WebURL: webURL,
+						Jobs:   []v1alpha1.PipelineJob{{ID: 7, Name: "seed", Stage: "deploy", Status: "success"}},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, jobClient: tc.jobClient}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipeline),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: pipeline(),
+			},
+			want: want{
+				cr:  pipeline(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreatePipeline: func(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+						if *opt.Ref != "main" || len(*opt.Variables) != 1 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Pipeline{ID: pipelineID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipeline(withProjectID(projectID)),
+			},
+			want: want{
+				cr:     pipeline(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"SecretVariable": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("s3cr3t")}
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockCreatePipeline: func(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+						if v := (*opt.Variables)[0]; *v.Key != "PASSWORD" || *v.Value != "s3cr3t" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Pipeline{ID: pipelineID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withVariables(secretVariable)),
+			},
+			want: want{
+				cr:     pipeline(withProjectID(projectID), withVariables(secretVariable), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreatePipeline: func(pid interface{}, opt *gitlab.CreatePipelineOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Pipeline, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipeline(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  pipeline(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, jobClient: tc.jobClient}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipeline),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeletePipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipeline(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeletePipeline: func(pid interface{}, pipeline int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipeline(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipeline(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, jobClient: tc.jobClient}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package pipelineschedules
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -36,6 +37,7 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
 )
 
 const (
@@ -47,9 +49,12 @@ const (
 	errCreatePipelineSchedule         = "failed to create PipelineSchedule"
 	errUpdatePipelineSchedule         = "failed to update PipelineSchedule"
 	errDeletePipelineSchedule         = "failed to delete PipelineSchedule"
-	errCreatePipelineScheduleVariable = "failed to create PipelineScheduleVariable %v"
-	errUpdatePipelineScheduleVariable = "failed to update PipelineScheduleVariable %v"
-	errDeletePipelineScheduleVariable = "failed to delete PipelineScheduleVariable %v"
+	errCreatePipelineScheduleVariable = "failed to create PipelineScheduleVariable %s"
+	errUpdatePipelineScheduleVariable = "failed to update PipelineScheduleVariable %s"
+	errDeletePipelineScheduleVariable = "failed to delete PipelineScheduleVariable %s"
+	errGetCurrentUser                 = "failed to get current user"
+	errTakeOwnership                  = "failed to take ownership of PipelineSchedule"
+	msgLastPipeline                   = "pipeline %d status is %s"
 )
 
 // SetupPipelineSchedule adds a controller that reconciles PipelineSchedule.
@@ -65,6 +70,7 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 				&connector{
 					kube:              mgr.GetClient(),
 					newGitlabClientFn: newPipelineScheduleClient,
+					newUserClientFn:   users.NewCurrentUserClient,
 				},
 			),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
@@ -75,11 +81,15 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 type external struct {
 	kube   client.Client
 	client projects.PipelineScheduleClient
+	// userID is the ID of the user of the ProviderConfig, only looked up
+	// when the schedule has takeOwnership set.
+	userID int
 }
 
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(c clients.Config) projects.PipelineScheduleClient
+	newUserClientFn   func(c clients.Config) users.CurrentUserClient
 }
 
 // Connect implements managed.ExternalConnecter.
@@ -94,10 +104,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{
-		kube:   c.kube,
-		client: c.newGitlabClientFn(*conf),
-	}, nil
+	e := &external{kube: c.kube, client: c.newGitlabClientFn(*conf)}
+	if takeOwnership(cr) {
+		u, _, err := c.newUserClientFn(*conf).CurrentUser(gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCurrentUser)
+		}
+		e.userID = u.ID
+	}
+	return e, nil
 }
 
 // Observe implements managed.ExternalClient.
@@ -132,12 +147,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, ps)
+
+	vars, err := projects.ResolvePipelineVariables(ctx, e.kube, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	generateObservation(cr, ps)
 	cr.Status.SetConditions(xpv1.Available())
+	if c, ok := lastPipelineCondition(ps.LastPipeline); ok {
+		cr.Status.SetConditions(c)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isUpToDate(cr, ps),
+		ResourceUpToDate:        isUpToDate(cr, vars, ps) && !e.needsOwnership(cr),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -153,6 +177,11 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNoProjectID)
 	}
 
+	vars, err := projects.ResolvePipelineVariables(ctx, e.kube, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	opt := &gitlab.CreatePipelineScheduleOptions{
 		Description:  &cr.Spec.ForProvider.Description,
 		Ref:          &cr.Spec.ForProvider.Ref,
@@ -169,7 +198,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	meta.SetExternalName(cr, strconv.Itoa(ps.ID))
 
-	for _, v := range cr.Spec.ForProvider.Variables {
+	for _, v := range vars {
 		opt := &gitlab.CreatePipelineScheduleVariableOptions{
 			Key:          &v.Key,
 			Value:        &v.Value,
@@ -181,7 +210,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			opt,
 		)
 		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
+			return managed.ExternalCreation{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v.Key)
 		}
 	}
 
@@ -206,6 +235,18 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNoProjectID)
 	}
 
+	vars, err := projects.ResolvePipelineVariables(ctx, e.kube, cr.Spec.ForProvider.Variables)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Ownership is taken first since only the owner may edit a schedule.
+	if e.needsOwnership(cr) {
+		if _, _, err := e.client.TakeOwnershipOfPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errTakeOwnership)
+		}
+	}
+
 	opt := &gitlab.EditPipelineScheduleOptions{
 		Description:  &cr.Spec.ForProvider.Description,
 		Ref:          &cr.Spec.ForProvider.Ref,
@@ -229,7 +270,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		if err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errGetPipelineSchedule)
 		}
-		for _, v := range cr.Spec.ForProvider.Variables {
+		for _, v := range vars {
 			if notSaved(v, ps.Variables) {
 				opt := &gitlab.CreatePipelineScheduleVariableOptions{
 					Key:          &v.Key,
@@ -242,7 +283,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					opt,
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v.Key)
 				}
 			}
 			if notUpdated(v, ps.Variables) {
@@ -257,7 +298,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					opt,
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdatePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdatePipelineScheduleVariable, v.Key)
 				}
 			}
 		}
@@ -269,7 +310,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					v.Key,
 				)
 				if err != nil {
-					return managed.ExternalUpdate{}, errors.Wrapf(err, errDeletePipelineScheduleVariable, v)
+					return managed.ExternalUpdate{}, errors.Wrapf(err, errDeletePipelineScheduleVariable, v.Key)
 				}
 			}
 		}
@@ -302,6 +343,36 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(err, errDeletePipelineSchedule)
 }
 
+// needsOwnership reports whether takeOwnership is set and the observed owner
+// of the schedule is not the user of the ProviderConfig.
+func (e *external) needsOwnership(cr *v1alpha1.PipelineSchedule) bool {
+	if !takeOwnership(cr) {
+		return false
+	}
+	owner := cr.Status.AtProvider.Owner
+	return owner == nil || owner.ID != e.userID
+}
+
+func takeOwnership(cr *v1alpha1.PipelineSchedule) bool {
+	return cr.Spec.ForProvider.TakeOwnership != nil && *cr.Spec.ForProvider.TakeOwnership
+}
+
+// lastPipelineCondition returns the condition derived from the status of the
+// last pipeline run by a schedule, if it ran one.
+func lastPipelineCondition(lp *gitlab.LastPipeline) (xpv1.Condition, bool) {
+	if lp == nil {
+		return xpv1.Condition{}, false
+	}
+	switch lp.Status {
+	case projects.PipelineStatusSuccess:
+		return v1alpha1.LastPipelineSucceeded(), true
+	case "failed", "canceled":
+		return v1alpha1.LastPipelineFailed(fmt.Sprintf(msgLastPipeline, lp.ID, lp.Status)), true
+	default:
+		return v1alpha1.LastPipelineRunning(fmt.Sprintf(msgLastPipeline, lp.ID, lp.Status)), true
+	}
+}
+
 func newPipelineScheduleClient(c clients.Config) projects.PipelineScheduleClient {
 	return clients.NewClient(c).PipelineSchedules
 }
@@ -329,7 +400,9 @@ func lateInitialize(cr *v1alpha1.PipelineScheduleParameters, ps *gitlab.Pipeline
 	}
 }
 
-func isUpToDate(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSchedule) bool {
+// isUpToDate compares cr with ps, using vars as its variables with their
+// values resolved.
+func isUpToDate(cr *v1alpha1.PipelineSchedule, vars []v1alpha1.PipelineVariable, ps *gitlab.PipelineSchedule) bool {
 	if cr.Spec.ForProvider.Cron != ps.Cron {
 		return false
 	}
@@ -342,7 +415,7 @@ func isUpToDate(cr *v1alpha1.PipelineSchedule, ps *gitlab.PipelineSchedule) bool
 	if !clients.IsBoolEqualToBoolPtr(cr.Spec.ForProvider.Active, ps.Active) {
 		return false
 	}
-	if !isVariablesUpToDate(cr.Spec.ForProvider.Variables, ps.Variables) {
+	if !isVariablesUpToDate(vars, ps.Variables) {
 		return false
 	}
 
@@ -355,7 +428,7 @@ func isVariablesUpToDate(crv []v1alpha1.PipelineVariable, inv []*gitlab.Pipeline
 	}
 	for _, v := range crv {
 
-		if notSaved(v, inv) {
+		if notSaved(v, inv) || notUpdated(v, inv) {
 			return false
 		}
 	}
@@ -386,19 +459,15 @@ func notSaved(crv v1alpha1.PipelineVariable, invArr []*gitlab.PipelineVariable)
 }
 
 func notUpdated(crv v1alpha1.PipelineVariable, invArr []*gitlab.PipelineVariable) bool {
-	victim := gitlab.PipelineVariable{
-		Key:   crv.Key,
-		Value: crv.Value,
-	}
-
-	if crv.VariableType != nil {
-		victim.VariableType = *crv.VariableType
-	}
-
 	for _, v := range invArr {
-		if victim.Key == v.Key && victim != *v {
+		if crv.Key != v.Key {
+			continue
+		}
+		// An unset variable type is whatever Gitlab defaults it to.
+		if crv.VariableType != nil && *crv.VariableType != v.VariableType {
 			return true
 		}
+		return crv.Value != v.Value
 	}
 
 	return false
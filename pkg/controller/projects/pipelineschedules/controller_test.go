@@ -28,18 +28,27 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	apisv1beta1 "github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	usersfake "github.com/crossplane-contrib/provider-gitlab/pkg/clients/users/fake"
 )
 
 var (
 	s                = ""
 	f                = false
+	t                = true
 	errorMessage     = "restult: -expected, +actual: \n%s"
 	id               = 1234
 	standardID       = 0
@@ -75,6 +84,13 @@ var (
 		Value:        "testValue2Update",
 		VariableType: &s,
 	}
+	pvSecret = &v1alpha1.PipelineVariable{
+		Key: "testKey3",
+		ValueSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "nightly", Namespace: "crossplane-system"},
+			Key:             "token",
+		},
+	}
 	gPvArr = []*gitlab.PipelineVariable{
 		{
 			Key:          "testKey1",
@@ -89,10 +105,24 @@ var (
 	}
 )
 
+func withSecret(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return errors.Errorf("unexpected object type %T", obj)
+			}
+			secret.Data = data
+			return nil
+		},
+	}
+}
+
 type args struct {
 	cr     resource.Managed
 	kube   client.Client
 	client projects.PipelineScheduleClient
+	userID int
 }
 
 type psModifier func(*v1alpha1.PipelineSchedule)
@@ -122,6 +152,24 @@ func withVariables(varr ...*v1alpha1.PipelineVariable) psModifier {
 	}
 }
 
+func withTakeOwnership() psModifier {
+	return func(ps *v1alpha1.PipelineSchedule) { ps.Spec.ForProvider.TakeOwnership = &t }
+}
+
+func withOwner(id int) psModifier {
+	return func(ps *v1alpha1.PipelineSchedule) { ps.Status.AtProvider.Owner = &v1alpha1.User{ID: id} }
+}
+
+func withLastPipeline(lp *v1alpha1.LastPipeline) psModifier {
+	return func(ps *v1alpha1.PipelineSchedule) { ps.Status.AtProvider.LastPipeline = lp }
+}
+
+func withProviderConfig() psModifier {
+	return func(ps *v1alpha1.PipelineSchedule) {
+		ps.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	}
+}
+
 func withProjectID() psModifier {
 	return func(ps *v1alpha1.PipelineSchedule) { ps.Spec.ForProvider.ProjectID = &extName }
 }
@@ -134,6 +182,85 @@ func buildPs(m ...psModifier) *v1alpha1.PipelineSchedule {
 	return ps
 }
 
+func providerConfig(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	switch o := obj.(type) {
+	case *apisv1beta1.ProviderConfig:
+		o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+		o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{Key: "token"}
+	case *apisv1beta1.ProviderConfigUsage:
+		return kerrors.NewNotFound(schema.GroupResource{}, "")
+	}
+	return nil
+}
+
+func currentUser(u *gitlab.User, err error) func(clients.Config) users.CurrentUserClient {
+	return func(clients.Config) users.CurrentUserClient {
+		return &usersfake.MockClient{
+			MockCurrentUser: func(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+				return u, nil, err
+			},
+		}
+	}
+}
+
+func TestConnect(t *testing.T) {
+	kube := &test.MockClient{MockGet: providerConfig, MockCreate: test.NewMockCreateFn(nil)}
+	newClient := func(clients.Config) projects.PipelineScheduleClient { return &fake.MockClient{} }
+
+	type expected struct {
+		result managed.ExternalClient
+		err    error
+	}
+
+	tcs := map[string]struct {
+		cr     resource.Managed
+		userFn func(clients.Config) users.CurrentUserClient
+		expected
+	}{
+		"InValidInput": {
+			cr: nil,
+			expected: expected{
+				err: errors.New(errNotPipelineSchedule),
+			},
+		},
+		"NoOwnershipSkipsCurrentUser": {
+			cr:     buildPs(withProviderConfig()),
+			userFn: currentUser(nil, errors.New("unexpected call")),
+			expected: expected{
+				result: &external{kube: kube, client: &fake.MockClient{}},
+			},
+		},
+		"TakeOwnershipResolvesCurrentUser": {
+			cr:     buildPs(withProviderConfig(), withTakeOwnership()),
+			userFn: currentUser(&gitlab.User{ID: 1}, nil),
+			expected: expected{
+				result: &external{kube: kube, client: &fake.MockClient{}, userID: 1},
+			},
+		},
+		"FailedCurrentUser": {
+			cr:     buildPs(withProviderConfig(), withTakeOwnership()),
+			userFn: currentUser(nil, errors.New("unauthorized")),
+			expected: expected{
+				err: errors.Wrap(errors.New("unauthorized"), errGetCurrentUser),
+			},
+		},
+	}
+
+	for tn, tc := range tcs {
+		t.Run(tn, func(t *testing.T) {
+			c := &connector{kube: kube, newGitlabClientFn: newClient, newUserClientFn: tc.userFn}
+			result, err := c.Connect(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.expected.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf(errorMessage, diff)
+			}
+			if diff := cmp.Diff(tc.expected.result, result, cmp.AllowUnexported(external{}), cmpopts.IgnoreFields(external{}, "kube")); diff != "" {
+				t.Errorf(errorMessage, diff)
+			}
+		})
+	}
+}
+
 func TestObserve(t *testing.T) {
 	type expected struct {
 		cr     resource.Managed
@@ -295,6 +422,150 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SecretVariableChanged": {
+			args: args{
+				kube: withSecret(map[string][]byte{"token": []byte("rotated")}),
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{Variables: []*gitlab.PipelineVariable{{Key: "testKey3", Value: "initial"}}}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withVariables(pvSecret),
+					withID(standardID),
+					withConditions(xpv1.Available()),
+				),
+				err: nil,
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"SecretKeyMissing": {
+			args: args{
+				kube: withSecret(map[string][]byte{}),
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withVariables(pvSecret),
+				),
+				err: errors.Wrap(errors.New("crossplane-system/nightly has no key token"), "cannot resolve value of pipeline variable testKey3"),
+			},
+		},
+		"LastPipelineFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{LastPipeline: &gitlab.LastPipeline{ID: 7, Status: "failed"}}, nil, nil
+					},
+				},
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withExternalName(extName),
+					withID(standardID),
+					withLastPipeline(&v1alpha1.LastPipeline{ID: 7, Status: "failed"}),
+					withConditions(xpv1.Available()),
+					withConditions(v1alpha1.LastPipelineFailed("pipeline 7 status is failed")),
+				),
+				err: nil,
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"OwnedByOtherUser": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{Owner: &gitlab.User{ID: 2}}, nil, nil
+					},
+				},
+				userID: 1,
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withID(standardID),
+					withOwner(2),
+					withConditions(xpv1.Available()),
+				),
+				err: nil,
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"OwnedByCurrentUser": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{Owner: &gitlab.User{ID: 1}}, nil, nil
+					},
+				},
+				userID: 1,
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withID(standardID),
+					withOwner(1),
+					withConditions(xpv1.Available()),
+				),
+				err: nil,
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 		"SuccessUpToDateFalse": {
 			args: args{
 				client: &fake.MockClient{
@@ -326,7 +597,7 @@ func TestObserve(t *testing.T) {
 
 	for tn, tc := range tcs {
 		t.Run(tn, func(t *testing.T) {
-			victim := &external{kube: tc.kube, client: tc.client}
+			victim := &external{kube: tc.kube, client: tc.client, userID: tc.userID}
 			result, err := victim.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.expected.err, err, test.EquateErrors()); diff != "" {
@@ -392,11 +663,42 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"CreateWithSecretVariable": {
+			args: args{
+				kube: withSecret(map[string][]byte{"token": []byte("s3cr3t")}),
+				client: &fake.MockClient{
+					MockCreatePipelineSchedule: func(pid interface{}, opt *gitlab.CreatePipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{
+							ID: id,
+						}, nil, nil
+					},
+					MockCreatePipelineScheduleVariable: func(pid interface{}, schedule int, opt *gitlab.CreatePipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error) {
+						if *opt.Key != "testKey3" || *opt.Value != "s3cr3t" {
+							return nil, nil, errors.New("unexpected variable")
+						}
+						return nil, nil, nil
+					},
+				},
+				cr: buildPs(
+					withProjectID(),
+					withVariables(pvSecret),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withProjectID(),
+					withExternalName(extName),
+					withVariables(pvSecret),
+				),
+				err:    nil,
+				result: managed.ExternalCreation{},
+			},
+		},
 	}
 
 	for tn, tc := range tcs {
 		t.Run(tn, func(t *testing.T) {
-			victim := &external{kube: tc.kube, client: tc.client}
+			victim := &external{kube: tc.kube, client: tc.client, userID: tc.userID}
 			result, err := victim.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.expected.err, err, test.EquateErrors()); diff != "" {
@@ -469,6 +771,61 @@ func TestUpdate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"TakeOwnership": {
+			args: args{
+				client: &fake.MockClient{
+					MockTakeOwnershipOfPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{}, nil, nil
+					},
+					MockEditPipelineSchedule: func(pid interface{}, schedule int, opt *gitlab.EditPipelineScheduleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return &gitlab.PipelineSchedule{}, nil, nil
+					},
+				},
+				userID: 1,
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withOwner(2),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withOwner(2),
+				),
+				result: managed.ExternalUpdate{},
+				err:    nil,
+			},
+		},
+		"TakeOwnershipFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockTakeOwnershipOfPipelineSchedule: func(pid interface{}, schedule int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineSchedule, *gitlab.Response, error) {
+						return nil, nil, errors.New("forbidden")
+					},
+				},
+				userID: 1,
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withOwner(2),
+				),
+			},
+			expected: expected{
+				cr: buildPs(
+					withParams(standardPsParams),
+					withTakeOwnership(),
+					withExternalName(extName),
+					withOwner(2),
+				),
+				result: managed.ExternalUpdate{},
+				err:    errors.Wrap(errors.New("forbidden"), errTakeOwnership),
+			},
+		},
 		"VariablesCreateSuccess": {
 			args: args{
 				client: &fake.MockClient{
@@ -560,7 +917,7 @@ func TestUpdate(t *testing.T) {
 
 	for tn, tc := range tcs {
 		t.Run(tn, func(t *testing.T) {
-			victim := &external{kube: tc.kube, client: tc.client}
+			victim := &external{kube: tc.kube, client: tc.client, userID: tc.userID}
 			result, err := victim.Update(context.Background(), tc.args.cr)
 			if diff := cmp.Diff(tc.expected.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf(errorMessage, diff)
@@ -612,7 +969,7 @@ func TestDelete(t *testing.T) {
 
 	for tn, tc := range tcs {
 		t.Run(tn, func(t *testing.T) {
-			victim := &external{kube: tc.kube, client: tc.client}
+			victim := &external{kube: tc.kube, client: tc.client, userID: tc.userID}
 			err := victim.Delete(context.Background(), tc.args.cr)
 			if diff := cmp.Diff(tc.expected.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf(errorMessage, diff)
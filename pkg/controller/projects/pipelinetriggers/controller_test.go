@@ -0,0 +1,422 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinetriggers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	triggerID    = 42
+	description  = "Triggered by the release system"
+	token        = "glptt-0123456789abcdef"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.PipelineTriggerClient
+	cr     resource.Managed
+}
+
+type pipelineTriggerModifier func(*v1alpha1.PipelineTrigger)
+
+func withConditions(c ...xpv1.Condition) pipelineTriggerModifier {
+	return func(r *v1alpha1.PipelineTrigger) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id string) pipelineTriggerModifier {
+	return func(r *v1alpha1.PipelineTrigger) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withExternalName(n string) pipelineTriggerModifier {
+	return func(r *v1alpha1.PipelineTrigger) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s v1alpha1.PipelineTriggerObservation) pipelineTriggerModifier {
+	return func(r *v1alpha1.PipelineTrigger) { r.Status.AtProvider = s }
+}
+
+func pipelineTrigger(m ...pipelineTriggerModifier) *v1alpha1.PipelineTrigger {
+	cr := &v1alpha1.PipelineTrigger{}
+	cr.Spec.ForProvider.Description = description
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipelineTrigger),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: pipelineTrigger(withProjectID(projectID)),
+			},
+			want: want{
+				cr: pipelineTrigger(withProjectID(projectID)),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("fr")),
+			},
+			want: want{
+				cr:  pipelineTrigger(withProjectID(projectID), withExternalName("fr")),
+				err: errors.New(errIDnotInt),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: pipelineTrigger(withExternalName("42")),
+			},
+			want: want{
+				cr:  pipelineTrigger(withExternalName("42")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return &gitlab.PipelineTrigger{ID: trigger, Description: description, Owner: &gitlab.User{ID: 1, Username: "root"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipelineTrigger(
+					withProjectID(projectID),
+					withExternalName("42"),
+					withStatus(v1alpha1.PipelineTriggerObservation{Owner: &v1alpha1.User{ID: 1, Username: "root"}}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetPipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return &gitlab.PipelineTrigger{ID: trigger, Description: "Created by hand"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipelineTrigger(
+					withProjectID(projectID),
+					withExternalName("42"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipelineTrigger),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: pipelineTrigger(),
+			},
+			want: want{
+				cr:  pipelineTrigger(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddPipelineTrigger: func(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						if *opt.Description != description {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.PipelineTrigger{ID: triggerID, Token: token}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID)),
+			},
+			want: want{
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddPipelineTrigger: func(pid interface{}, opt *gitlab.AddPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  pipelineTrigger(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipelineTrigger),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditPipelineTrigger: func(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						if trigger != triggerID || *opt.Description != description {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.PipelineTrigger{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditPipelineTrigger: func(pid interface{}, trigger int, opt *gitlab.EditPipelineTriggerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineTrigger, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotPipelineTrigger),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeletePipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeletePipelineTrigger: func(pid interface{}, trigger int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pipelineTrigger(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  pipelineTrigger(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
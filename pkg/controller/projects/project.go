@@ -17,14 +17,20 @@ limitations under the License.
 package projects
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,38 +41,70 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 )
 
 const (
-	errNotProject       = "managed resource is not a Gitlab project custom resource"
-	errKubeUpdateFailed = "cannot update Gitlab project custom resource"
-	errCreateFailed     = "cannot create Gitlab project"
-	errUpdateFailed     = "cannot update Gitlab project"
-	errDeleteFailed     = "cannot delete Gitlab project"
-	errGetFailed        = "cannot retrieve Gitlab project with"
+	errNotProject        = "managed resource is not a Gitlab project custom resource"
+	errCreateFailed      = "cannot create Gitlab project"
+	errUpdateFailed      = "cannot update Gitlab project"
+	errDeleteFailed      = "cannot delete Gitlab project"
+	errGetFailed         = "cannot retrieve Gitlab project with"
+	errShareFailed       = "cannot share Gitlab project with group: %v"
+	errUnshareFailed     = "cannot unshare Gitlab project from group: %v"
+	errMissingGroupID    = "missing group ID for group to share with"
+	errSWGMissingGroupID = "following SharedWithGroup is missing GroupID: %v"
+	errTransferFailed    = "cannot transfer Gitlab project to namespace %d"
+	errArchiveFailed     = "cannot archive Gitlab project"
+	errUnarchiveFailed   = "cannot unarchive Gitlab project"
+	errRemoveFailed      = "cannot permanently remove Gitlab project"
+	errGetNamespace      = "cannot get Gitlab namespace %d"
+	errAdoptFailed       = "cannot look up existing Gitlab project %s"
+	errForkFailed        = "cannot fork Gitlab project %d"
+	errImportFailed      = "Gitlab project import failed: %s"
+	errArchiveURLMissing = "cannot read import archive URL from secret"
+	errDownloadFailed    = "cannot download import archive"
+	errImportArchive     = "cannot import Gitlab project from archive"
+	errAvatarFailed      = "cannot read Gitlab project avatar"
+	errKubeUpdateFailed  = "cannot update Gitlab project custom resource"
+)
+
+// archiveClient downloads import archives. The timeout covers reading the
+// archive while it is uploaded to GitLab, and matches the time the managed
+// reconciler allows for a reconcile.
+var archiveClient = &http.Client{Timeout: time.Minute}
+
+const (
+	reasonTransfer event.Reason = "TransferProject"
+	reasonAdopt    event.Reason = "AdoptProject"
+	reasonImport   event.Reason = "ImportProject"
 )
 
 // SetupProject adds a controller that reconciles Projects.
 func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.ProjectKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Project{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: projects.NewProjectClient, newNamespaceClientFn: projects.NewNamespaceClient, newImportExportClientFn: projects.NewImportExportClient}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(recorder)))
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) projects.Client
+	kube                    client.Client
+	recorder                event.Recorder
+	newGitlabClientFn       func(cfg clients.Config) projects.Client
+	newNamespaceClientFn    func(cfg clients.Config) projects.NamespaceClient
+	newImportExportClientFn func(cfg clients.Config) projects.ImportExportClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -78,12 +116,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{
+		kube:            c.kube,
+		recorder:        c.recorder,
+		client:          c.newGitlabClientFn(*cfg),
+		namespaceClient: c.newNamespaceClientFn(*cfg),
+		importClient:    c.newImportExportClientFn(*cfg),
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.Client
+	kube            client.Client
+	recorder        event.Recorder
+	client          projects.Client
+	namespaceClient projects.NamespaceClient
+	importClient    projects.ImportExportClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -97,12 +144,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	projectID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errNotProject)
-	}
-
-	prj, res, err := e.client.GetProject(projectID, nil)
+	// The external name is either the numeric ID or the full path of the
+	// project, GitLab accepts both.
+	prj, res, err := e.client.GetProject(externalName, nil)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -110,16 +154,68 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	if meta.WasDeleted(cr) && isProjectGone(cr.Spec.ForProvider.OnDelete, prj) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// A path is only used to find the project, from then on it is tracked by
+	// its ID so that it is still found after a rename or a transfer.
+	idChanged := false
+	if id := strconv.Itoa(prj.ID); externalName != id {
+		meta.SetExternalName(cr, id)
+		idChanged = true
+	}
+
+	// Whether the initial import finished is only known to this resource,
+	// keep it across observations.
+	importFinished := cr.Status.AtProvider.ImportFinished
+
+	// Projects populated from a fork, an import or a template are only edited
+	// and reported as available once GitLab has finished copying the
+	// repository. Later imports, such as the updates of a pull mirror, don't
+	// hold the project back.
+	if isImported(&cr.Spec.ForProvider) && !importFinished {
+		switch {
+		case isImportInProgress(prj.ImportStatus):
+			cr.Status.AtProvider = projects.GenerateObservation(prj)
+			cr.Status.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: idChanged}, nil
+		case prj.ImportStatus == "failed":
+			err := errors.Errorf(errImportFailed, prj.ImportError)
+			if cr.Status.AtProvider.ImportStatus != prj.ImportStatus {
+				e.recorder.Event(cr, event.Warning(reasonImport, err))
+			}
+			if recreateFailedImport(cr) {
+				// Report the project as missing, Create replaces it.
+				return managed.ExternalObservation{}, nil
+			}
+			cr.Status.AtProvider = projects.GenerateObservation(prj)
+			cr.Status.SetConditions(xpv1.Unavailable().WithMessage(err.Error()))
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: idChanged}, nil
+		}
+		importFinished = true
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, prj)
 
 	cr.Status.AtProvider = projects.GenerateObservation(prj)
+	cr.Status.AtProvider.ImportFinished = importFinished
 	cr.Status.SetConditions(xpv1.Available())
 
+	upToDate := isProjectUpToDate(&cr.Spec.ForProvider, prj)
+	if upToDate && cr.Spec.ForProvider.Avatar != nil {
+		_, sum, err := e.avatar(ctx, cr.Spec.ForProvider.Avatar)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = prj.AvatarURL != "" && sum == cr.GetAnnotations()[common.AnnotationKeyAvatarHash]
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isProjectUpToDate(&cr.Spec.ForProvider, prj),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: idChanged || !cmp.Equal(current, &cr.Spec.ForProvider),
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(prj.RunnersToken)},
 	}, nil
 }
@@ -130,31 +226,297 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotProject)
 	}
 
-	prj, _, err := e.client.CreateProject(
-		projects.GenerateCreateProjectOptions(cr.Name, &cr.Spec.ForProvider),
-		gitlab.WithContext(ctx),
-	)
+	if err := e.removeFailedImport(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if cr.Spec.ForProvider.AdoptExisting != nil && *cr.Spec.ForProvider.AdoptExisting {
+		prj, err := e.findExisting(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if prj != nil {
+			meta.SetExternalName(cr, strconv.Itoa(prj.ID))
+			e.recorder.Event(cr, event.Normal(reasonAdopt, fmt.Sprintf("Adopted existing project %s", prj.PathWithNamespace)))
+			return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+		}
+	}
+
+	if cr.Spec.ForProvider.ImportArchive != nil {
+		id, err := e.importArchive(ctx, cr)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		meta.SetExternalName(cr, strconv.Itoa(id))
+		return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+	}
+
+	if id := cr.Spec.ForProvider.ForkedFromProjectID; id != nil {
+		prj, _, err := e.client.ForkProject(
+			*id,
+			projects.GenerateForkProjectOptions(cr.Name, &cr.Spec.ForProvider),
+			gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrapf(err, errForkFailed, *id)
+		}
+		meta.SetExternalName(cr, strconv.Itoa(prj.ID))
+		return managed.ExternalCreation{ExternalNameAssigned: true}, e.share(ctx, cr, prj)
+	}
+
+	opt := projects.GenerateCreateProjectOptions(cr.Name, &cr.Spec.ForProvider)
+	if a := cr.Spec.ForProvider.Avatar; a != nil {
+		av, sum, err := e.avatar(ctx, a)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		opt.Avatar = av
+		meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyAvatarHash: sum})
+	}
+
+	prj, _, err := e.client.CreateProject(opt, gitlab.WithContext(ctx))
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
 	meta.SetExternalName(cr, strconv.Itoa(prj.ID))
-	return managed.ExternalCreation{ExternalNameAssigned: true}, errors.Wrap(err, errKubeUpdateFailed)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, e.share(ctx, cr, prj)
+}
+
+// removeFailedImport deletes the project left behind by a failed import, so
+// that it can be created again. Projects marked for deletion keep their path
+// until they are removed for good, which happens on the next attempt.
+func (e *external) removeFailedImport(ctx context.Context, cr *v1alpha1.Project) error {
+	id := meta.GetExternalName(cr)
+	if id == "" || !recreateFailedImport(cr) {
+		return nil
+	}
+
+	prj, res, err := e.client.GetProject(id, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil
+		}
+		return errors.Wrap(err, errGetFailed)
+	}
+	if prj.ImportStatus != "failed" {
+		return nil
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonImport, fmt.Sprintf("Deleting project %s to import it again", prj.PathWithNamespace)))
+	if prj.MarkedForDeletionAt == nil {
+		_, err := e.client.DeleteProject(id, gitlab.WithContext(ctx))
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	res, err = e.client.DeleteProject(id, projects.WithPermanentlyRemove(prj.PathWithNamespace), gitlab.WithContext(ctx))
+	if err != nil && !clients.IsResponseNotFound(res) {
+		return errors.Wrap(err, errRemoveFailed)
+	}
+	return nil
 }
 
-func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+// importArchive streams the archive referenced by spec.forProvider.importArchive
+// into a new project and returns the ID of the project.
+func (e *external) importArchive(ctx context.Context, cr *v1alpha1.Project) (int, error) {
+	ref := cr.Spec.ForProvider.ImportArchive.URLSecretRef
+	secret := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return 0, errors.Wrap(err, errArchiveURLMissing)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, string(secret.Data[ref.Key]), nil)
+	if err != nil {
+		return 0, errors.Wrap(err, errDownloadFailed)
+	}
+	res, err := archiveClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, errDownloadFailed)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, errors.Wrap(errors.New(res.Status), errDownloadFailed)
+	}
+
+	st, _, err := e.importClient.ImportFromFile(
+		res.Body,
+		projects.GenerateImportFileOptions(cr.Name, &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, errImportArchive)
+	}
+	return st.ID, nil
+}
+
+// avatar reads the image referenced by a and returns it ready for upload
+// along with the hash of its content.
+func (e *external) avatar(ctx context.Context, a *common.Avatar) (*gitlab.ProjectAvatar, string, error) {
+	obj, key, err := clients.AvatarSource(a)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errAvatarFailed)
+	}
+	img, sum, err := clients.GetAvatar(ctx, e.kube, obj, key)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errAvatarFailed)
+	}
+	return &gitlab.ProjectAvatar{Filename: key, Image: bytes.NewReader(img)}, sum, nil
+}
+
+// findExisting returns the project living at the namespace and path of p, or
+// nil if there is none or p lacks either of them.
+func (e *external) findExisting(ctx context.Context, p *v1alpha1.ProjectParameters) (*gitlab.Project, error) {
+	if p.NamespaceID == nil || p.Path == nil {
+		return nil, nil
+	}
+
+	ns, _, err := e.namespaceClient.GetNamespace(*p.NamespaceID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetNamespace, *p.NamespaceID)
+	}
+
+	fullPath := ns.FullPath + "/" + *p.Path
+	prj, res, err := e.client.GetProject(fullPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, errAdoptFailed, fullPath)
+	}
+	return prj, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
 	cr, ok := mg.(*v1alpha1.Project)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotProject)
 	}
 
-	_, _, err := e.client.EditProject(
+	archived := cr.Status.AtProvider.Archived
+	if archived && cr.Spec.ForProvider.Archived != nil && !*cr.Spec.ForProvider.Archived {
+		if _, _, err := e.client.UnarchiveProject(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUnarchiveFailed)
+		}
+		archived = false
+	}
+	if archived {
+		// Archived projects are read-only and GitLab rejects any edit.
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if err := e.transfer(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	opt := projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider)
+	avatarHash := ""
+	if a := cr.Spec.ForProvider.Avatar; a != nil {
+		av, sum, err := e.avatar(ctx, a)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if sum != cr.GetAnnotations()[common.AnnotationKeyAvatarHash] || cr.Status.AtProvider.AvatarURL == "" {
+			opt.Avatar = av
+			avatarHash = sum
+		}
+	}
+
+	prj, _, err := e.client.EditProject(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if avatarHash != "" {
+		// The reconciler only saves the status after an update, store the
+		// annotation holding the hash of the uploaded avatar here.
+		meta.AddAnnotations(cr, map[string]string{common.AnnotationKeyAvatarHash: avatarHash})
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	if err := e.share(ctx, cr, prj); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.unshare(ctx, cr, prj); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if cr.Spec.ForProvider.Archived != nil && *cr.Spec.ForProvider.Archived {
+		if _, _, err := e.client.ArchiveProject(prj.ID, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errArchiveFailed)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// share shares prj with every group of spec.forProvider.sharedWithGroups it
+// is not yet shared with.
+func (e *external) share(ctx context.Context, cr *v1alpha1.Project, prj *gitlab.Project) error {
+	for _, sh := range cr.Spec.ForProvider.SharedWithGroups {
+		if sh.GroupID == nil {
+			return errors.New(errMissingGroupID)
+		}
+		if !notShared(*sh.GroupID, prj) {
+			continue
+		}
+		opt := gitlab.ShareWithGroupOptions{
+			GroupID:     sh.GroupID,
+			GroupAccess: (*gitlab.AccessLevelValue)(&sh.GroupAccessLevel),
+		}
+		if sh.ExpiresAt != nil {
+			opt.ExpiresAt = gitlab.String(sh.ExpiresAt.Format("2006-01-02"))
+		}
+		if _, err := e.client.ShareProjectWithGroup(prj.ID, &opt, gitlab.WithContext(ctx)); err != nil {
+			return errors.Wrapf(err, errShareFailed, *sh.GroupID)
+		}
+	}
+	return nil
+}
+
+// unshare removes the shares of prj with groups that are not part of
+// spec.forProvider.sharedWithGroups. Shares are left alone when the field is
+// unset, so that they can be managed outside of this resource.
+func (e *external) unshare(ctx context.Context, cr *v1alpha1.Project, prj *gitlab.Project) error {
+	if cr.Spec.ForProvider.SharedWithGroups == nil {
+		return nil
+	}
+	for _, sh := range prj.SharedWithGroups {
+		isNotUnshared, err := notUnshared(sh.GroupID, cr.Spec.ForProvider.SharedWithGroups)
+		if err != nil {
+			return errors.Wrap(err, errUpdateFailed)
+		}
+		if isNotUnshared {
+			if _, err := e.client.DeleteSharedProjectFromGroup(prj.ID, sh.GroupID, gitlab.WithContext(ctx)); err != nil {
+				return errors.Wrapf(err, errUnshareFailed, sh.GroupID)
+			}
+		}
+	}
+	return nil
+}
+
+// transfer moves the project to spec.forProvider.namespaceId if it currently
+// lives in a different namespace.
+func (e *external) transfer(ctx context.Context, cr *v1alpha1.Project) error {
+	to := cr.Spec.ForProvider.NamespaceID
+	if to == nil || cr.Status.AtProvider.Namespace == nil || cr.Status.AtProvider.Namespace.ID == *to {
+		return nil
+	}
+	from := cr.Status.AtProvider.Namespace.FullPath
+
+	e.recorder.Event(cr, event.Normal(reasonTransfer, fmt.Sprintf("Transferring project from namespace %s to namespace %d", from, *to)))
+	_, _, err := e.client.TransferProject(
 		meta.GetExternalName(cr),
-		projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider),
+		&gitlab.TransferProjectOptions{Namespace: *to},
 		gitlab.WithContext(ctx),
 	)
-
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	if err != nil {
+		err = errors.Wrapf(err, errTransferFailed, *to)
+		e.recorder.Event(cr, event.Warning(reasonTransfer, err))
+		return err
+	}
+	e.recorder.Event(cr, event.Normal(reasonTransfer, fmt.Sprintf("Transferred project from namespace %s to namespace %d", from, *to)))
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -163,8 +525,72 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotProject)
 	}
 
-	_, err := e.client.DeleteProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
-	return errors.Wrap(err, errDeleteFailed)
+	switch onDelete(cr.Spec.ForProvider.OnDelete) {
+	case v1alpha1.OnDeleteArchive:
+		_, _, err := e.client.ArchiveProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+		return errors.Wrap(err, errArchiveFailed)
+	case v1alpha1.OnDeletePermanentlyRemove:
+		// GitLab only permanently removes projects that are already marked
+		// for deletion. Instances without delayed deletion remove the project
+		// on the first call; otherwise the next reconcile observes the mark
+		// and removes it for good.
+		if cr.Status.AtProvider.MarkedForDeletionAt == nil {
+			_, err := e.client.DeleteProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+			return errors.Wrap(err, errDeleteFailed)
+		}
+		res, err := e.client.DeleteProject(
+			meta.GetExternalName(cr),
+			projects.WithPermanentlyRemove(cr.Status.AtProvider.PathWithNamespace),
+			gitlab.WithContext(ctx),
+		)
+		if err != nil && !clients.IsResponseNotFound(res) {
+			return errors.Wrap(err, errRemoveFailed)
+		}
+		return nil
+	default:
+		_, err := e.client.DeleteProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+		return errors.Wrap(err, errDeleteFailed)
+	}
+}
+
+// onDelete returns the deletion mode, defaulting to Delete.
+func onDelete(v *v1alpha1.OnDeleteValue) v1alpha1.OnDeleteValue {
+	if v == nil {
+		return v1alpha1.OnDeleteDelete
+	}
+	return *v
+}
+
+// isImported reports whether the repository of a project is populated from
+// another source when it is created.
+func isImported(p *v1alpha1.ProjectParameters) bool {
+	return p.ForkedFromProjectID != nil || p.ImportArchive != nil || p.ImportURL != nil || p.TemplateName != nil || p.TemplateProjectID != nil
+}
+
+// recreateFailedImport reports whether a project whose import failed should
+// be deleted and created again.
+func recreateFailedImport(cr *v1alpha1.Project) bool {
+	return !meta.WasDeleted(cr) && cr.GetAnnotations()[v1alpha1.AnnotationKeyRecreateFailedImport] == "true"
+}
+
+// isImportInProgress reports whether GitLab is still populating the
+// repository of a project.
+func isImportInProgress(status string) bool {
+	return status == "scheduled" || status == "started"
+}
+
+// isProjectGone reports whether a project that is being deleted has reached
+// the state requested by the deletion mode, even though GitLab still returns
+// it.
+func isProjectGone(v *v1alpha1.OnDeleteValue, prj *gitlab.Project) bool {
+	switch onDelete(v) {
+	case v1alpha1.OnDeleteArchive:
+		return prj.Archived
+	case v1alpha1.OnDeleteDelayedDelete:
+		return prj.MarkedForDeletionAt != nil
+	default:
+		return false
+	}
 }
 
 // lateInitialize fills the empty fields in the project spec with the
@@ -179,6 +605,9 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	if in.ApprovalsBeforeMerge == nil {
 		in.ApprovalsBeforeMerge = &project.ApprovalsBeforeMerge
 	}
+	if in.Archived == nil {
+		in.Archived = &project.Archived
+	}
 	if in.AutocloseReferencedIssues == nil {
 		in.AutocloseReferencedIssues = &project.AutocloseReferencedIssues
 	}
@@ -263,6 +692,9 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	if in.SharedRunnersEnabled == nil {
 		in.SharedRunnersEnabled = &project.SharedRunnersEnabled
 	}
+	if in.GroupRunnersEnabled == nil {
+		in.GroupRunnersEnabled = &project.GroupRunnersEnabled
+	}
 
 	in.SnippetsAccessLevel = clients.LateInitializeAccessControlValue(in.SnippetsAccessLevel, project.SnippetsAccessLevel)
 	in.SuggestionCommitMessage = clients.LateInitializeStringPtr(in.SuggestionCommitMessage, project.SuggestionCommitMessage)
@@ -277,9 +709,20 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 
 // isProjectUpToDate checks whether there is a change in any of the modifiable fields.
 func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool { // nolint:gocyclo
+	if !clients.IsBoolEqualToBoolPtr(p.Archived, g.Archived) {
+		return false
+	}
+	if g.Archived {
+		// Nothing but the archived state can be changed on an archived
+		// project, so any other drift is ignored until it is unarchived.
+		return true
+	}
 	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
 		return false
 	}
+	if p.NamespaceID != nil && g.Namespace != nil && *p.NamespaceID != g.Namespace.ID {
+		return false
+	}
 	if !clients.IsBoolEqualToBoolPtr(p.AllowMergeOnSkippedPipeline, g.AllowMergeOnSkippedPipeline) {
 		return false
 	}
@@ -388,6 +831,9 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if !clients.IsBoolEqualToBoolPtr(p.SharedRunnersEnabled, g.SharedRunnersEnabled) {
 		return false
 	}
+	if !clients.IsBoolEqualToBoolPtr(p.GroupRunnersEnabled, g.GroupRunnersEnabled) {
+		return false
+	}
 	if p.SnippetsAccessLevel != nil && !cmp.Equal(string(*p.SnippetsAccessLevel), string(g.SnippetsAccessLevel)) {
 		return false
 	}
@@ -403,5 +849,64 @@ func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool {
 	if p.WikiAccessLevel != nil && !cmp.Equal(string(*p.WikiAccessLevel), string(g.WikiAccessLevel)) {
 		return false
 	}
+	if p.SharedWithGroups != nil {
+		if ok, err := isSharedWithGroupsUpToDate(p, g); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func isSharedWithGroupsUpToDate(cr *v1alpha1.ProjectParameters, in *gitlab.Project) (bool, error) {
+	if len(cr.SharedWithGroups) != len(in.SharedWithGroups) {
+		return false, nil
+	}
+
+	inIDs := make(map[int]any)
+	for _, v := range in.SharedWithGroups {
+		inIDs[v.GroupID] = nil
+	}
+
+	crIDs := make(map[int]any)
+	for _, v := range cr.SharedWithGroups {
+		if v.GroupID == nil {
+			return false, errors.Errorf(errSWGMissingGroupID, v)
+		}
+		crIDs[*v.GroupID] = nil
+	}
+
+	for ID := range inIDs {
+		if _, ok := crIDs[ID]; !ok {
+			return false, nil
+		}
+	}
+
+	for ID := range crIDs {
+		if _, ok := inIDs[ID]; !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func notUnshared(groupID int, sh []v1alpha1.SharedWithGroupsParameters) (bool, error) {
+	for _, cr := range sh {
+		if cr.GroupID == nil {
+			return false, errors.Errorf(errSWGMissingGroupID, cr)
+		}
+		if groupID == *cr.GroupID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func notShared(groupID int, prj *gitlab.Project) bool {
+	for _, in := range prj.SharedWithGroups {
+		if in.GroupID == groupID {
+			return false
+		}
+	}
 	return true
 }
@@ -18,22 +18,32 @@ package projects
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
@@ -44,14 +54,36 @@ var (
 	unexpecedItem     resource.Managed
 	errBoom           = errors.New("boom")
 	projectID         = 1234
+	sharedGroupID     = 5678
+	namespaceID       = 10
+	newNamespaceID    = 11
+	upstreamProjectID = 4321
 	extName           = strconv.Itoa(projectID)
 	extNameAnnotation = map[string]string{meta.AnnotationKeyExternalName: extName}
+
+	gitlabMarkedForDeletionAt = gitlab.ISOTime(time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC))
+	markedForDeletionAt       = metav1.NewTime(time.Time(gitlabMarkedForDeletionAt))
+
+	avatarURL   = "https://gitlab.example.com/uploads/avatar.png"
+	avatarImage = []byte("avatar")
+	avatarHash  = fmt.Sprintf("%x", sha256.Sum256(avatarImage))
 )
 
+func avatarConfigMap(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, cm)
+	}
+	cm.BinaryData = map[string][]byte{"avatar.png": avatarImage}
+	return nil
+}
+
 type args struct {
-	project projects.Client
-	kube    client.Client
-	cr      resource.Managed
+	project      projects.Client
+	namespace    projects.NamespaceClient
+	importExport projects.ImportExportClient
+	kube         client.Client
+	cr           resource.Managed
 }
 
 type projectModifier func(*v1alpha1.Project)
@@ -76,18 +108,42 @@ func withSpec(s v1alpha1.ProjectParameters) projectModifier {
 	return func(r *v1alpha1.Project) { r.Spec.ForProvider = s }
 }
 
+func withImportFinished() projectModifier {
+	return func(r *v1alpha1.Project) { r.Status.AtProvider.ImportFinished = true }
+}
+
+func withSharedWithGroups(sh []v1alpha1.SharedWithGroupsParameters) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.SharedWithGroups = sh }
+}
+
+// sharedWith returns a GitLab project shared with the groups of ids.
+func sharedWith(ids ...int) *gitlab.Project {
+	prj := &gitlab.Project{}
+	for _, id := range ids {
+		prj.SharedWithGroups = append(prj.SharedWithGroups, struct {
+			GroupID          int    `json:"group_id"`
+			GroupName        string `json:"group_name"`
+			GroupFullPath    string `json:"group_full_path"`
+			GroupAccessLevel int    `json:"group_access_level"`
+		}{GroupID: id})
+	}
+	return prj
+}
+
 func withClientDefaultValues() projectModifier {
 	return func(p *v1alpha1.Project) {
 		f := false
 		i := 0
 		p.Spec.ForProvider = v1alpha1.ProjectParameters{
 			AllowMergeOnSkippedPipeline:               &f,
+			Archived:                                  &f,
 			CIForwardDeploymentEnabled:                &f,
 			NamespaceID:                               &i,
 			EmailsDisabled:                            &f,
 			ResolveOutdatedDiffDiscussions:            &f,
 			ContainerRegistryEnabled:                  &f,
 			SharedRunnersEnabled:                      &f,
+			GroupRunnersEnabled:                       &f,
 			PublicBuilds:                              &f,
 			OnlyAllowMergeIfPipelineSucceeds:          &f,
 			OnlyAllowMergeIfAllDiscussionsAreResolved: &f,
@@ -119,6 +175,63 @@ func withAnnotations(a map[string]string) projectModifier {
 	return func(p *v1alpha1.Project) { meta.AddAnnotations(p, a) }
 }
 
+func withAdoptExisting(b bool) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.AdoptExisting = &b }
+}
+
+func withForkedFromProjectID(id int) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.ForkedFromProjectID = &id }
+}
+
+func withAvatar() projectModifier {
+	return func(p *v1alpha1.Project) {
+		p.Spec.ForProvider.Avatar = &common.Avatar{
+			ConfigMapKeyRef: &common.ConfigMapKeySelector{Name: "brand", Namespace: "default", Key: "avatar.png"},
+		}
+	}
+}
+
+func withAvatarHash(hash string) projectModifier {
+	return func(p *v1alpha1.Project) {
+		meta.AddAnnotations(p, map[string]string{common.AnnotationKeyAvatarHash: hash})
+	}
+}
+
+func withImportURL(u string) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.ImportURL = &u }
+}
+
+func withImportArchive(key string) projectModifier {
+	return func(p *v1alpha1.Project) {
+		p.Spec.ForProvider.ImportArchive = &v1alpha1.ImportArchive{
+			URLSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "archive", Namespace: "crossplane-system"},
+				Key:             key,
+			},
+		}
+	}
+}
+
+func withTemplateName(n string) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.TemplateName = &n }
+}
+
+func withArchived(b bool) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.Archived = &b }
+}
+
+func withOnDelete(v v1alpha1.OnDeleteValue) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.OnDelete = &v }
+}
+
+func withDeletionTimestamp() projectModifier {
+	return func(p *v1alpha1.Project) { p.SetDeletionTimestamp(&markedForDeletionAt) }
+}
+
+func withNamespaceID(id int) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.NamespaceID = &id }
+}
+
 func withMirrorUserIDNil() projectModifier {
 	return func(p *v1alpha1.Project) { p.Spec.ForProvider.MirrorUserID = nil }
 }
@@ -211,18 +324,147 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
+		"PathExternalName": {
 			args: args{
 				project: &fake.MockClient{
 					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
-						return &gitlab.Project{}, &gitlab.Response{}, nil
+						if pid != path {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+						}
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
 					},
 				},
-				cr: project(withExternalName("fr")),
+				cr: project(withExternalName(path)),
 			},
 			want: want{
-				cr:  project(withExternalName("fr")),
-				err: errors.New(errNotProject),
+				cr: project(withExternalName(path)),
+			},
+		},
+		"PathExternalNamePinnedToID": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if pid != path {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withClientDefaultValues(), withExternalName(path)),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withStatus(v1alpha1.ProjectObservation{ID: projectID}),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": {}},
+				},
+			},
+		},
+		"ForkImportInProgress": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "started"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withForkedFromProjectID(upstreamProjectID)),
+			},
+			want: want{
+				cr: project(
+					withExternalName(extName),
+					withForkedFromProjectID(upstreamProjectID),
+					withStatus(v1alpha1.ProjectObservation{ID: projectID, ImportStatus: "started"}),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TemplateImportScheduled": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "scheduled"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withTemplateName("rails")),
+			},
+			want: want{
+				cr: project(
+					withExternalName(extName),
+					withTemplateName("rails"),
+					withStatus(v1alpha1.ProjectObservation{ID: projectID, ImportStatus: "scheduled"}),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ImportFailed": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "failed", ImportError: "repository not found"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withImportURL("https://example.com/repo.git")),
+			},
+			want: want{
+				cr: project(
+					withExternalName(extName),
+					withImportURL("https://example.com/repo.git"),
+					withStatus(v1alpha1.ProjectObservation{ID: projectID, ImportStatus: "failed", ImportError: "repository not found"}),
+					withConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errImportFailed, "repository not found"))),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ImportFailedRecreate": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "failed", ImportError: "repository not found"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+			},
+			want: want{
+				cr:     project(withExternalName(extName), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"MirrorUpdateAfterImportFinished": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ImportStatus: "started"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withImportFinished(),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withStatus(v1alpha1.ProjectObservation{ImportStatus: "started"}),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": {}},
+				},
 			},
 		},
 		"FailedGetRequest": {
@@ -264,13 +506,14 @@ func TestObserve(t *testing.T) {
 				},
 				cr: project(
 					withClientDefaultValues(),
-					withExternalName(extName),
+					withExternalName("0"),
 				),
 			},
 			want: want{
 				cr: project(
 					withClientDefaultValues(),
-					withExternalName(extName),
+					withExternalName("0"),
+					withImportFinished(),
 					withConditions(xpv1.Available()),
 				),
 				result: managed.ExternalObservation{
@@ -281,6 +524,153 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AvatarChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project", AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+					withAvatarHash("outdated"),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL}),
+					withAvatarHash("outdated"),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"AvatarUnchanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project", AvatarURL: avatarURL}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+					withAvatarHash(avatarHash),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+					withStatus(v1alpha1.ProjectObservation{AvatarURL: avatarURL}),
+					withAvatarHash(avatarHash),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"FailedAvatarRead": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("0"),
+					withAvatar(),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get default/brand"), errAvatarFailed),
+			},
+		},
+		"NamespaceChanged": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: namespaceID}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withNamespaceID(newNamespaceID),
+					withExternalName("0"),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withNamespaceID(newNamespaceID),
+					withExternalName("0"),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.ProjectObservation{Namespace: &v1alpha1.ProjectNamespace{ID: namespaceID}}),
+					withImportFinished(),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"ArchivedOnDelete": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withOnDelete(v1alpha1.OnDeleteArchive), withDeletionTimestamp(), withExternalName(extName)),
+			},
+			want: want{
+				cr:     project(withOnDelete(v1alpha1.OnDeleteArchive), withDeletionTimestamp(), withExternalName(extName)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MarkedForDeletionOnDelete": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{MarkedForDeletionAt: &gitlabMarkedForDeletionAt}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withOnDelete(v1alpha1.OnDeleteDelayedDelete), withDeletionTimestamp(), withExternalName(extName)),
+			},
+			want: want{
+				cr:     project(withOnDelete(v1alpha1.OnDeleteDelayedDelete), withDeletionTimestamp(), withExternalName(extName)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -293,7 +683,7 @@ func TestObserve(t *testing.T) {
 				},
 				cr: project(
 					withClientDefaultValues(),
-					withExternalName(extName),
+					withExternalName("0"),
 				),
 			},
 			want: want{
@@ -301,8 +691,9 @@ func TestObserve(t *testing.T) {
 					withClientDefaultValues(),
 					withConditions(xpv1.Available()),
 					withPath(&path),
-					withExternalName(extName),
+					withExternalName("0"),
 					withStatus(v1alpha1.ProjectObservation{}),
+					withImportFinished(),
 				),
 				result: managed.ExternalObservation{
 					ResourceExists:          true,
@@ -312,55 +703,113 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"LateInitSuccessMirrorUserIdZero": {
+		"UnmanagedSharedWithGroups": {
 			args: args{
-				kube: &test.MockClient{
-					MockUpdate: test.NewMockUpdateFn(nil),
-				},
 				project: &fake.MockClient{
 					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
-						return &gitlab.Project{MirrorUserID: 0}, &gitlab.Response{}, nil
+						return sharedWith(sharedGroupID), &gitlab.Response{}, nil
 					},
 				},
 				cr: project(
 					withClientDefaultValues(),
-					withMirrorUserIDNil(),
-					withExternalName(extName),
+					withExternalName("0"),
 				),
 			},
 			want: want{
 				cr: project(
 					withClientDefaultValues(),
-					withMirrorUserIDNil(),
-					withExternalName(extName),
+					withExternalName("0"),
+					withStatus(v1alpha1.ProjectObservation{SharedWithGroups: []v1alpha1.SharedWithGroups{{GroupID: sharedGroupID}}}),
+					withImportFinished(),
 					withConditions(xpv1.Available()),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:          true,
-					ResourceUpToDate:        true,
-					ResourceLateInitialized: false,
-					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": {}},
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": {}},
 				},
 			},
 		},
-	}
-
-	isProjectUpToDateCases := map[string]interface{}{
-		"Name":                                      "name",
-		"Path":                                      "path",
-		"DefaultBranch":                             "Default branch",
-		"Description":                               "description",
-		"IssuesAccessLevel":                         gitlab.PrivateAccessControl,
-		"RepositoryAccessLevel":                     gitlab.PrivateAccessControl,
-		"MergeRequestsAccessLevel":                  gitlab.PrivateAccessControl,
-		"ForkingAccessLevel":                        gitlab.PrivateAccessControl,
-		"BuildsAccessLevel":                         gitlab.PrivateAccessControl,
-		"WikiAccessLevel":                           gitlab.PrivateAccessControl,
-		"SnippetsAccessLevel":                       gitlab.PrivateAccessControl,
-		"PagesAccessLevel":                          gitlab.PrivateAccessControl,
+		"SharedWithGroupsNotUpToDate": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return sharedWith(sharedGroupID), &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{}),
+					withExternalName("0"),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{}),
+					withExternalName("0"),
+					withStatus(v1alpha1.ProjectObservation{SharedWithGroups: []v1alpha1.SharedWithGroups{{GroupID: sharedGroupID}}}),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"runnersToken": {}},
+				},
+			},
+		},
+		"LateInitSuccessMirrorUserIdZero": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{MirrorUserID: 0}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withMirrorUserIDNil(),
+					withExternalName("0"),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withMirrorUserIDNil(),
+					withExternalName("0"),
+					withImportFinished(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": {}},
+				},
+			},
+		},
+	}
+
+	isProjectUpToDateCases := map[string]interface{}{
+		"Name":                                      "name",
+		"Path":                                      "path",
+		"DefaultBranch":                             "Default branch",
+		"Description":                               "description",
+		"IssuesAccessLevel":                         gitlab.PrivateAccessControl,
+		"RepositoryAccessLevel":                     gitlab.PrivateAccessControl,
+		"MergeRequestsAccessLevel":                  gitlab.PrivateAccessControl,
+		"ForkingAccessLevel":                        gitlab.PrivateAccessControl,
+		"BuildsAccessLevel":                         gitlab.PrivateAccessControl,
+		"WikiAccessLevel":                           gitlab.PrivateAccessControl,
+		"SnippetsAccessLevel":                       gitlab.PrivateAccessControl,
+		"PagesAccessLevel":                          gitlab.PrivateAccessControl,
 		"ResolveOutdatedDiffDiscussions":            true,
 		"ContainerRegistryEnabled":                  true,
 		"SharedRunnersEnabled":                      true,
+		"GroupRunnersEnabled":                       true,
 		"Visibility":                                gitlab.PrivateVisibility,
 		"PublicBuilds":                              true,
 		"OnlyAllowMergeIfPipelineSucceeds":          true,
@@ -409,6 +858,7 @@ func TestObserve(t *testing.T) {
 		ResolveOutdatedDiffDiscussions:   &f,
 		ContainerRegistryEnabled:         &f,
 		SharedRunnersEnabled:             &f,
+		GroupRunnersEnabled:              &f,
 		Visibility:                       &visibility,
 		PublicBuilds:                     &f,
 		OnlyAllowMergeIfPipelineSucceeds: &f,
@@ -431,6 +881,7 @@ func TestObserve(t *testing.T) {
 		AutocloseReferencedIssues:        &f,
 		AllowMergeOnSkippedPipeline:      &f,
 		CIForwardDeploymentEnabled:       &f,
+		Archived:                         &f,
 	}
 
 	for name, value := range isProjectUpToDateCases {
@@ -459,6 +910,7 @@ func TestObserve(t *testing.T) {
 			ResolveOutdatedDiffDiscussions:   f,
 			ContainerRegistryEnabled:         f,
 			SharedRunnersEnabled:             f,
+			GroupRunnersEnabled:              f,
 			Visibility:                       gitlab.PublicVisibility,
 			PublicBuilds:                     f,
 			OnlyAllowMergeIfPipelineSucceeds: f,
@@ -514,7 +966,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.project}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -531,6 +983,29 @@ func TestObserve(t *testing.T) {
 }
 
 func TestCreate(t *testing.T) {
+	repo := "repo"
+
+	archive := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/export.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("archive"))
+	}))
+	defer archive.Close()
+
+	archiveSecret := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, secret)
+		}
+		secret.Data = map[string][]byte{
+			"url":     []byte(archive.URL + "/export.tar.gz"),
+			"missing": []byte(archive.URL + "/missing.tar.gz"),
+		}
+		return nil
+	}
+
 	type want struct {
 		cr     resource.Managed
 		result managed.ExternalCreation
@@ -567,6 +1042,90 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{ExternalNameAssigned: true},
 			},
 		},
+		"SuccessfulCreationSharedWithGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if pid != projectID || *opt.GroupID != sharedGroupID {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}})),
+			},
+			want: want{
+				cr: project(
+					withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}}),
+					withExternalName(extName),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedCreationShareWithGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}})),
+			},
+			want: want{
+				cr: project(
+					withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}}),
+					withExternalName(extName),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+				err:    errors.Wrapf(errBoom, errShareFailed, sharedGroupID),
+			},
+		},
+		"RecreateFailedImport": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "failed"}, &gitlab.Response{}, nil
+					},
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if pid != extName {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: upstreamProjectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+			},
+			want: want{
+				cr:     project(withExternalName(strconv.Itoa(upstreamProjectID)), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedRemoveFailedImport": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID, ImportStatus: "failed"}, &gitlab.Response{}, nil
+					},
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withExternalName(extName), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+			},
+			want: want{
+				cr:  project(withExternalName(extName), withAnnotations(map[string]string{v1alpha1.AnnotationKeyRecreateFailedImport: "true"}), withImportURL("https://example.com/repo.git")),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				project: &fake.MockClient{
@@ -581,10 +1140,194 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"SuccessfulFork": {
+			args: args{
+				project: &fake.MockClient{
+					MockForkProject: func(pid interface{}, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if pid != upstreamProjectID || *opt.NamespaceID != namespaceID {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withForkedFromProjectID(upstreamProjectID), withNamespaceID(namespaceID)),
+			},
+			want: want{
+				cr:     project(withForkedFromProjectID(upstreamProjectID), withNamespaceID(namespaceID), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedFork": {
+			args: args{
+				project: &fake.MockClient{
+					MockForkProject: func(pid interface{}, opt *gitlab.ForkProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withForkedFromProjectID(upstreamProjectID)),
+			},
+			want: want{
+				cr:  project(withForkedFromProjectID(upstreamProjectID)),
+				err: errors.Wrapf(errBoom, errForkFailed, upstreamProjectID),
+			},
+		},
+		"SuccessfulAdoption": {
+			args: args{
+				namespace: &fake.MockClient{
+					MockGetNamespace: func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return &gitlab.Namespace{ID: namespaceID, FullPath: "some/path/to"}, &gitlab.Response{}, nil
+					},
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if pid != "some/path/to/repo" {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+						}
+						return &gitlab.Project{ID: projectID, PathWithNamespace: path}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+			},
+			want: want{
+				cr:     project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"AdoptionCreatesMissingProject": {
+			args: args{
+				namespace: &fake.MockClient{
+					MockGetNamespace: func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return &gitlab.Namespace{ID: namespaceID, FullPath: "some/path/to"}, &gitlab.Response{}, nil
+					},
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+			},
+			want: want{
+				cr:     project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"SuccessfulArchiveImport": {
+			args: args{
+				kube: &test.MockClient{MockGet: archiveSecret},
+				importExport: &fake.MockClient{
+					MockImportFromFile: func(archive io.Reader, opt *gitlab.ImportFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error) {
+						b, err := io.ReadAll(archive)
+						if err != nil || string(b) != "archive" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.ImportStatus{ID: projectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withImportArchive("url"), withPath(&repo)),
+			},
+			want: want{
+				cr:     project(withImportArchive("url"), withPath(&repo), withExternalName(extName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedArchiveSecretRead": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   project(withImportArchive("url"), withPath(&repo)),
+			},
+			want: want{
+				cr:  project(withImportArchive("url"), withPath(&repo)),
+				err: errors.Wrap(errBoom, errArchiveURLMissing),
+			},
+		},
+		"FailedArchiveDownload": {
+			args: args{
+				kube: &test.MockClient{MockGet: archiveSecret},
+				cr:   project(withImportArchive("missing"), withPath(&repo)),
+			},
+			want: want{
+				cr:  project(withImportArchive("missing"), withPath(&repo)),
+				err: errors.Wrap(errors.New("404 Not Found"), errDownloadFailed),
+			},
+		},
+		"FailedArchiveImport": {
+			args: args{
+				kube: &test.MockClient{MockGet: archiveSecret},
+				importExport: &fake.MockClient{
+					MockImportFromFile: func(archive io.Reader, opt *gitlab.ImportFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ImportStatus, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withImportArchive("url"), withPath(&repo)),
+			},
+			want: want{
+				cr:  project(withImportArchive("url"), withPath(&repo)),
+				err: errors.Wrap(errBoom, errImportArchive),
+			},
+		},
+		"SuccessfulCreationWithAvatar": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap},
+				project: &fake.MockClient{
+					MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if opt.Avatar == nil || opt.Avatar.Filename != "avatar.png" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAvatar()),
+			},
+			want: want{
+				cr: project(
+					withAvatar(),
+					withExternalName(extName),
+					withAvatarHash(avatarHash),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedNamespaceLookup": {
+			args: args{
+				namespace: &fake.MockClient{
+					MockGetNamespace: func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+			},
+			want: want{
+				cr:  project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+				err: errors.Wrapf(errBoom, errGetNamespace, namespaceID),
+			},
+		},
+		"FailedAdoptionLookup": {
+			args: args{
+				namespace: &fake.MockClient{
+					MockGetNamespace: func(id interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Namespace, *gitlab.Response, error) {
+						return &gitlab.Namespace{ID: namespaceID, FullPath: "some/path/to"}, &gitlab.Response{}, nil
+					},
+				},
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+			},
+			want: want{
+				cr:  project(withAdoptExisting(true), withNamespaceID(namespaceID), withPath(&repo)),
+				err: errors.Wrapf(errBoom, errAdoptFailed, path),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.project, namespaceClient: tc.namespace, importClient: tc.importExport}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -648,10 +1391,299 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
+		"AvatarChangedUpload": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap, MockUpdate: test.NewMockUpdateFn(nil)},
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if opt.Avatar == nil {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash("outdated")),
+			},
+			want: want{
+				cr: project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash(avatarHash)),
+			},
+		},
+		"FailedAvatarHashUpdate": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap, MockUpdate: test.NewMockUpdateFn(errBoom)},
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash("outdated")),
+			},
+			want: want{
+				cr:  project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash(avatarHash)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"AvatarUnchangedSkipsUpload": {
+			args: args{
+				kube: &test.MockClient{MockGet: avatarConfigMap},
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						if opt.Avatar != nil {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash(avatarHash)),
+			},
+			want: want{
+				cr: project(withAvatar(), withStatus(v1alpha1.ProjectObservation{ID: 1234, AvatarURL: avatarURL}), withAvatarHash(avatarHash)),
+			},
+		},
+		"ArchivedProjectSkipsEdit": {
+			args: args{
+				project: &fake.MockClient{},
+				cr: project(
+					withArchived(true),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+			},
+			want: want{
+				cr: project(
+					withArchived(true),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+			},
+		},
+		"SuccessfulUnarchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockUnarchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withArchived(false),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+			},
+			want: want{
+				cr: project(
+					withArchived(false),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+			},
+		},
+		"FailedUnarchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockUnarchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(
+					withArchived(false),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+			},
+			want: want{
+				cr: project(
+					withArchived(false),
+					withStatus(v1alpha1.ProjectObservation{Archived: true}),
+				),
+				err: errors.Wrap(errBoom, errUnarchiveFailed),
+			},
+		},
+		"SuccessfulArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withArchived(true)),
+			},
+			want: want{
+				cr: project(withArchived(true)),
+			},
+		},
+		"FailedArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withArchived(true)),
+			},
+			want: want{
+				cr:  project(withArchived(true)),
+				err: errors.Wrap(errBoom, errArchiveFailed),
+			},
+		},
+		"SuccessfulTransfer": {
+			args: args{
+				project: &fake.MockClient{
+					MockTransferProject: func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &newNamespaceID}),
+					withStatus(v1alpha1.ProjectObservation{Namespace: &v1alpha1.ProjectNamespace{ID: namespaceID}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &newNamespaceID}),
+					withStatus(v1alpha1.ProjectObservation{Namespace: &v1alpha1.ProjectNamespace{ID: namespaceID}}),
+				),
+			},
+		},
+		"FailedTransfer": {
+			args: args{
+				project: &fake.MockClient{
+					MockTransferProject: func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &newNamespaceID}),
+					withStatus(v1alpha1.ProjectObservation{Namespace: &v1alpha1.ProjectNamespace{ID: namespaceID}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &newNamespaceID}),
+					withStatus(v1alpha1.ProjectObservation{Namespace: &v1alpha1.ProjectNamespace{ID: namespaceID}}),
+				),
+				err: errors.Wrapf(errBoom, errTransferFailed, newNamespaceID),
+			},
+		},
+		"SuccessfulShareWithGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: 1234}, &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}},
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}},
+				})),
+			},
+		},
+		"FailedShareWithGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: 1234}, &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}},
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupID: &sharedGroupID, GroupAccessLevel: 30}},
+				})),
+				err: errors.Wrapf(errBoom, errShareFailed, sharedGroupID),
+			},
+		},
+		"MissingSharedGroupID": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: 1234}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupAccessLevel: 30}},
+				})),
+			},
+			want: want{
+				cr: project(withSpec(v1alpha1.ProjectParameters{
+					SharedWithGroups: []v1alpha1.SharedWithGroupsParameters{{GroupAccessLevel: 30}},
+				})),
+				err: errors.New(errMissingGroupID),
+			},
+		},
+		"SuccessfulUnshareFromGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return sharedWith(sharedGroupID), &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{})),
+			},
+			want: want{
+				cr: project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{})),
+			},
+		},
+		"FailedUnshareFromGroup": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return sharedWith(sharedGroupID), &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{})),
+			},
+			want: want{
+				cr:  project(withSharedWithGroups([]v1alpha1.SharedWithGroupsParameters{})),
+				err: errors.Wrapf(errBoom, errUnshareFailed, sharedGroupID),
+			},
+		},
+		"UnmanagedSharesKept": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return sharedWith(sharedGroupID), &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(),
+			},
+			want: want{
+				cr: project(),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.project}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -667,6 +1699,78 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+type countingRecorder struct{ events int }
+
+func (r *countingRecorder) Event(_ runtime.Object, _ event.Event) { r.events++ }
+
+func (r *countingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestImportFailedEventOnce(t *testing.T) {
+	cl := &fake.MockClient{
+		MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			return &gitlab.Project{ID: projectID, ImportStatus: "failed"}, &gitlab.Response{}, nil
+		},
+	}
+	rec := &countingRecorder{}
+	e := &external{recorder: rec, client: cl}
+	cr := project(withExternalName(extName), withImportURL("https://example.com/repo.git"))
+
+	for i := 0; i < 3; i++ {
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("Observe(...): %v", err)
+		}
+	}
+	if diff := cmp.Diff(1, rec.events); diff != "" {
+		t.Errorf("events: -want, +got:\n%s", diff)
+	}
+}
+
+func TestObserveAfterTransfer(t *testing.T) {
+	transferred := false
+	cl := &fake.MockClient{
+		MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			// The project is no longer found at its old path once transferred.
+			if pid != extName && (pid != path || transferred) {
+				return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+			}
+			ns := namespaceID
+			if transferred {
+				ns = newNamespaceID
+			}
+			return &gitlab.Project{ID: projectID, Namespace: &gitlab.ProjectNamespace{ID: ns}}, &gitlab.Response{}, nil
+		},
+		MockTransferProject: func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			if pid != extName {
+				return nil, &gitlab.Response{}, errBoom
+			}
+			transferred = true
+			return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+		},
+		MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+			return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
+		},
+	}
+	cr := project(withClientDefaultValues(), withNamespaceID(newNamespaceID), withExternalName(path))
+	e := &external{recorder: event.NewNopRecorder(), client: cl}
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceExists {
+		t.Errorf("Observe(...): transferred project reported as missing")
+	}
+	if diff := cmp.Diff(extName, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("external name: -want, +got:\n%s", diff)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type want struct {
 		cr  resource.Managed
@@ -714,6 +1818,91 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errBoom, errDeleteFailed),
 			},
 		},
+		"SuccessfulArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withOnDelete(v1alpha1.OnDeleteArchive)),
+			},
+			want: want{
+				cr: project(withOnDelete(v1alpha1.OnDeleteArchive)),
+			},
+		},
+		"FailedArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withOnDelete(v1alpha1.OnDeleteArchive)),
+			},
+			want: want{
+				cr:  project(withOnDelete(v1alpha1.OnDeleteArchive)),
+				err: errors.Wrap(errBoom, errArchiveFailed),
+			},
+		},
+		"PermanentlyRemoveMarksForDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if len(options) != 1 {
+							return nil, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withOnDelete(v1alpha1.OnDeletePermanentlyRemove)),
+			},
+			want: want{
+				cr: project(withOnDelete(v1alpha1.OnDeletePermanentlyRemove)),
+			},
+		},
+		"PermanentlyRemoveMarkedProject": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if len(options) != 2 {
+							return nil, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withOnDelete(v1alpha1.OnDeletePermanentlyRemove),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &markedForDeletionAt}),
+				),
+			},
+			want: want{
+				cr: project(
+					withOnDelete(v1alpha1.OnDeletePermanentlyRemove),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &markedForDeletionAt}),
+				),
+			},
+		},
+		"FailedPermanentlyRemove": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: project(
+					withOnDelete(v1alpha1.OnDeletePermanentlyRemove),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &markedForDeletionAt}),
+				),
+			},
+			want: want{
+				cr: project(
+					withOnDelete(v1alpha1.OnDeletePermanentlyRemove),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &markedForDeletionAt}),
+				),
+				err: errors.Wrap(errBoom, errRemoveFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectrunners
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotProjectRunner = "managed resource is not a Gitlab project runner custom resource"
+	errGetFailed        = "cannot get Gitlab runner"
+	errEnableFailed     = "cannot enable Gitlab runner for project"
+	errDisableFailed    = "cannot disable Gitlab runner for project"
+	errProjectIDMissing = "ProjectID is missing"
+	errRunnerIDMissing  = "RunnerID is missing"
+)
+
+// SetupProjectRunner adds a controller that reconciles ProjectRunners.
+func SetupProjectRunner(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectRunnerKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProjectRunner{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectRunnerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectRunnerClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ProjectRunnerClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRunner)
+	if !ok {
+		return nil, errors.New(errNotProjectRunner)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProjectRunnerClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRunner)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectRunner)
+	}
+	if err := validate(cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	r, res, err := e.client.GetRunnerDetails(*cr.Spec.ForProvider.RunnerID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	if !projects.IsRunnerEnabledForProject(r, *cr.Spec.ForProvider.ProjectID) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = projects.GenerateProjectRunnerObservation(r)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectRunner)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectRunner)
+	}
+	if err := validate(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, _, err := e.client.EnableProjectRunner(
+		*cr.Spec.ForProvider.ProjectID,
+		&gitlab.EnableProjectRunnerOptions{RunnerID: *cr.Spec.ForProvider.RunnerID},
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalCreation{}, errors.Wrap(err, errEnableFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// an assignment has nothing to update, both of its IDs are immutable
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectRunner)
+	if !ok {
+		return errors.New(errNotProjectRunner)
+	}
+	if err := validate(cr); err != nil {
+		return err
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DisableProjectRunner(*cr.Spec.ForProvider.ProjectID, *cr.Spec.ForProvider.RunnerID, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDisableFailed)
+}
+
+func validate(cr *v1alpha1.ProjectRunner) error {
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+	if cr.Spec.ForProvider.RunnerID == nil {
+		return errors.New(errRunnerIDMissing)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectrunners
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = 1234
+	runnerID     = 42
+	description  = "GPU runner"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.ProjectRunnerClient
+	cr     resource.Managed
+}
+
+type projectRunnerModifier func(*v1alpha1.ProjectRunner)
+
+func withConditions(c ...xpv1.Condition) projectRunnerModifier {
+	return func(r *v1alpha1.ProjectRunner) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id int) projectRunnerModifier {
+	return func(r *v1alpha1.ProjectRunner) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withRunnerID(id int) projectRunnerModifier {
+	return func(r *v1alpha1.ProjectRunner) { r.Spec.ForProvider.RunnerID = &id }
+}
+
+func withStatus(s v1alpha1.ProjectRunnerObservation) projectRunnerModifier {
+	return func(r *v1alpha1.ProjectRunner) { r.Status.AtProvider = s }
+}
+
+func projectRunner(m ...projectRunnerModifier) *v1alpha1.ProjectRunner {
+	cr := &v1alpha1.ProjectRunner{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func runnerDetails(pids ...int) *gitlab.RunnerDetails {
+	r := &gitlab.RunnerDetails{ID: runnerID, Description: description, Status: "online", Online: true}
+	for _, pid := range pids {
+		r.Projects = append(r.Projects, struct {
+			ID                int    `json:"id"`
+			Name              string `json:"name"`
+			NameWithNamespace string `json:"name_with_namespace"`
+			Path              string `json:"path"`
+			PathWithNamespace string `json:"path_with_namespace"`
+		}{ID: pid})
+	}
+	return r
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotProjectRunner),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: projectRunner(withRunnerID(runnerID)),
+			},
+			want: want{
+				cr:  projectRunner(withRunnerID(runnerID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"MissingRunnerID": {
+			args: args{
+				cr: projectRunner(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  projectRunner(withProjectID(projectID)),
+				err: errors.New(errRunnerIDMissing),
+			},
+		},
+		"RunnerNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr:  projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return runnerDetails(1), &gitlab.Response{}, nil
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return runnerDetails(1, projectID), &gitlab.Response{}, nil
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr: projectRunner(
+					withProjectID(projectID),
+					withRunnerID(runnerID),
+					withStatus(v1alpha1.ProjectRunnerObservation{Description: description, Status: "online", Online: true}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotProjectRunner),
+			},
+		},
+		"MissingRunnerID": {
+			args: args{
+				cr: projectRunner(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  projectRunner(withProjectID(projectID)),
+				err: errors.New(errRunnerIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockEnableProjectRunner: func(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error) {
+						if pid != projectID || opt.RunnerID != runnerID {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Runner{ID: runnerID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockEnableProjectRunner: func(pid interface{}, opt *gitlab.EnableProjectRunnerOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Runner, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr:  projectRunner(withProjectID(projectID), withRunnerID(runnerID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errEnableFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotProjectRunner),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDisableProjectRunner: func(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if pid != projectID || runner != runnerID {
+							return &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDisableProjectRunner: func(pid interface{}, runner int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: projectRunner(withProjectID(projectID), withRunnerID(runnerID)),
+			},
+			want: want{
+				cr:  projectRunner(withProjectID(projectID), withRunnerID(runnerID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDisableFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotRelease       = "managed resource is not a Gitlab release custom resource"
+	errGetFailed        = "cannot get Gitlab release"
+	errCreateFailed     = "cannot create Gitlab release"
+	errUpdateFailed     = "cannot update Gitlab release"
+	errDeleteFailed     = "cannot delete Gitlab release"
+	errDeleteTagFailed  = "cannot delete tag of Gitlab release"
+	errListLinksFailed  = "cannot list Gitlab release links"
+	errCreateLinkFailed = "cannot create Gitlab release link %s"
+	errUpdateLinkFailed = "cannot update Gitlab release link %d"
+	errDeleteLinkFailed = "cannot delete Gitlab release link %d"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupRelease adds a controller that reconciles Releases.
+func SetupRelease(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ReleaseKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Release{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ReleaseGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewReleaseClient, newReleaseLinkClientFn: projects.NewReleaseLinkClient, newTagClientFn: projects.NewTagClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube                   client.Client
+	newGitlabClientFn      func(cfg clients.Config) projects.ReleaseClient
+	newReleaseLinkClientFn func(cfg clients.Config) projects.ReleaseLinkClient
+	newTagClientFn         func(cfg clients.Config) projects.TagClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return nil, errors.New(errNotRelease)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.kube,
+		client:     c.newGitlabClientFn(*cfg),
+		linkClient: c.newReleaseLinkClientFn(*cfg),
+		tagClient:  c.newTagClientFn(*cfg),
+	}, nil
+}
+
+type external struct {
+	kube       client.Client
+	client     projects.ReleaseClient
+	linkClient projects.ReleaseLinkClient
+	tagClient  projects.TagClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	r, res, err := e.client.GetRelease(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	links, err := e.links(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = projects.GenerateReleaseObservation(r, links)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsReleaseUpToDate(&cr.Spec.ForProvider, r, links),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, _, err := e.client.CreateRelease(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateReleaseOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	pid := *cr.Spec.ForProvider.ProjectID
+	tag := cr.Spec.ForProvider.TagName
+
+	if _, _, err := e.client.UpdateRelease(pid, tag, projects.GenerateUpdateReleaseOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	links, err := e.links(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Links are removed first so a renamed link does not clash with the URL
+	// of the link it replaces.
+	d := projects.DiffReleaseLinks(cr.Spec.ForProvider.Links, links)
+	for _, id := range d.Delete {
+		if _, _, err := e.linkClient.DeleteReleaseLink(pid, tag, id, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteLinkFailed, id)
+		}
+	}
+	for id, opt := range d.Update {
+		if _, _, err := e.linkClient.UpdateReleaseLink(pid, tag, id, opt, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateLinkFailed, id)
+		}
+	}
+	for _, opt := range d.Create {
+		if _, _, err := e.linkClient.CreateReleaseLink(pid, tag, opt, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errCreateLinkFailed, *opt.Name)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Release)
+	if !ok {
+		return errors.New(errNotRelease)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// GitLab deletes a release along with its tag.
+	if d := cr.Spec.ForProvider.DeleteTag; d != nil && *d {
+		_, err := e.tagClient.DeleteTag(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+		return errors.Wrap(err, errDeleteTagFailed)
+	}
+
+	_, _, err := e.client.DeleteRelease(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// links returns all asset links of the release of cr.
+func (e *external) links(ctx context.Context, cr *v1alpha1.Release) ([]*gitlab.ReleaseLink, error) {
+	var all []*gitlab.ReleaseLink
+	opt := &gitlab.ListReleaseLinksOptions{PerPage: 100}
+	for {
+		links, res, err := e.linkClient.ListReleaseLinks(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.TagName, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errListLinksFailed)
+		}
+		all = append(all, links...)
+		if res == nil || res.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
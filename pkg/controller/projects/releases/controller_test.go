@@ -0,0 +1,508 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package releases
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	tagName      = "v1.0.0"
+	releaseName  = "Release 1.0.0"
+	webURL       = "https://gitlab.example.com/group/project/-/releases/v1.0.0"
+	packageURL   = "https://registry.example.com/app/1.0.0"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube       client.Client
+	client     projects.ReleaseClient
+	linkClient projects.ReleaseLinkClient
+	tagClient  projects.TagClient
+	cr         resource.Managed
+}
+
+type releaseModifier func(*v1alpha1.Release)
+
+func withConditions(c ...xpv1.Condition) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id string) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withName(n string) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.Name = &n }
+}
+
+func withLinks(l ...v1alpha1.ReleaseLink) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.Links = l }
+}
+
+func withDeleteTag() releaseModifier {
+	return func(r *v1alpha1.Release) { r.Spec.ForProvider.DeleteTag = gitlab.Bool(true) }
+}
+
+func withStatus(s v1alpha1.ReleaseObservation) releaseModifier {
+	return func(r *v1alpha1.Release) { r.Status.AtProvider = s }
+}
+
+func release(m ...releaseModifier) *v1alpha1.Release {
+	cr := &v1alpha1.Release{}
+	cr.Spec.ForProvider.TagName = tagName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRelease(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.Release, *gitlab.Response, error) {
+	r := &projects.Release{Release: gitlab.Release{TagName: tagName, Name: releaseName}}
+	r.Links.Self = webURL
+	return r, &gitlab.Response{}, nil
+}
+
+func listLinks(links ...*gitlab.ReleaseLink) func(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error) {
+	return func(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error) {
+		return links, &gitlab.Response{}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRelease),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: release(),
+			},
+			want: want{
+				cr:  release(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr: release(withProjectID(projectID)),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*projects.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FailedListLinks": {
+			args: args{
+				client: &fake.MockClient{MockGetRelease: getRelease},
+				linkClient: &fake.MockClient{
+					MockListReleaseLinks: func(pid interface{}, tagName string, opt *gitlab.ListReleaseLinksOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ReleaseLink, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errListLinksFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client:     &fake.MockClient{MockGetRelease: getRelease},
+				linkClient: &fake.MockClient{MockListReleaseLinks: listLinks(&gitlab.ReleaseLink{ID: 1, Name: "package", URL: packageURL, LinkType: gitlab.PackageLinkType})},
+				cr: release(
+					withProjectID(projectID),
+					withName(releaseName),
+					withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL, LinkType: gitlab.String("package")}),
+				),
+			},
+			want: want{
+				cr: release(
+					withProjectID(projectID),
+					withName(releaseName),
+					withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL, LinkType: gitlab.String("package")}),
+					withStatus(v1alpha1.ReleaseObservation{
+						WebURL: webURL,
+						Links:  []v1alpha1.ReleaseLinkObservation{{ID: 1, Name: "package", URL: packageURL, LinkType: "package"}},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LinkMissing": {
+			args: args{
+				client:     &fake.MockClient{MockGetRelease: getRelease},
+				linkClient: &fake.MockClient{MockListReleaseLinks: listLinks()},
+				cr:         release(withProjectID(projectID), withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL})),
+			},
+			want: want{
+				cr: release(
+					withProjectID(projectID),
+					withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL}),
+					withStatus(v1alpha1.ReleaseObservation{WebURL: webURL}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, linkClient: tc.linkClient, tagClient: tc.tagClient}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRelease),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRelease: func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						if *opts.TagName != tagName || len(opts.Assets.Links) != 1 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Release{TagName: tagName}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(projectID), withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL})),
+			},
+			want: want{
+				cr: release(withProjectID(projectID), withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL}), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRelease: func(pid interface{}, opts *gitlab.CreateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, linkClient: tc.linkClient, tagClient: tc.tagClient}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	updateRelease := func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+		return &gitlab.Release{}, &gitlab.Response{}, nil
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRelease),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateRelease: func(pid interface{}, tagName string, opts *gitlab.UpdateReleaseOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"SyncLinks": {
+			args: args{
+				client: &fake.MockClient{MockUpdateRelease: updateRelease},
+				linkClient: &fake.MockClient{
+					MockListReleaseLinks: listLinks(
+						&gitlab.ReleaseLink{ID: 1, Name: "stale", URL: "https://example.com/stale", LinkType: gitlab.OtherLinkType},
+						&gitlab.ReleaseLink{ID: 2, Name: "package", URL: "https://example.com/old", LinkType: gitlab.OtherLinkType},
+					),
+					MockDeleteReleaseLink: func(pid interface{}, tagName string, link int, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+						if link != 1 {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+					},
+					MockUpdateReleaseLink: func(pid interface{}, tagName string, link int, opt *gitlab.UpdateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+						if link != 2 || *opt.URL != packageURL {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+					},
+					MockCreateReleaseLink: func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+						if *opt.Name != "runbook" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.ReleaseLink{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(
+					withProjectID(projectID),
+					withLinks(
+						v1alpha1.ReleaseLink{Name: "package", URL: packageURL},
+						v1alpha1.ReleaseLink{Name: "runbook", URL: "https://example.com/runbook", LinkType: gitlab.String("runbook")},
+					),
+				),
+			},
+			want: want{
+				cr: release(
+					withProjectID(projectID),
+					withLinks(
+						v1alpha1.ReleaseLink{Name: "package", URL: packageURL},
+						v1alpha1.ReleaseLink{Name: "runbook", URL: "https://example.com/runbook", LinkType: gitlab.String("runbook")},
+					),
+				),
+			},
+		},
+		"FailedCreateLink": {
+			args: args{
+				client: &fake.MockClient{MockUpdateRelease: updateRelease},
+				linkClient: &fake.MockClient{
+					MockListReleaseLinks: listLinks(),
+					MockCreateReleaseLink: func(pid interface{}, tagName string, opt *gitlab.CreateReleaseLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ReleaseLink, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID), withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL})),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID), withLinks(v1alpha1.ReleaseLink{Name: "package", URL: packageURL})),
+				err: errors.Wrapf(errBoom, errCreateLinkFailed, "package"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, linkClient: tc.linkClient, tagClient: tc.tagClient}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRelease),
+			},
+		},
+		"DeleteTag": {
+			args: args{
+				tagClient: &fake.MockClient{
+					MockDeleteTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(projectID), withDeleteTag()),
+			},
+			want: want{
+				cr: release(withProjectID(projectID), withDeleteTag(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeleteTag": {
+			args: args{
+				tagClient: &fake.MockClient{
+					MockDeleteTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID), withDeleteTag()),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID), withDeleteTag(), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteTagFailed),
+			},
+		},
+		"DeleteRelease": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return &gitlab.Release{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr: release(withProjectID(projectID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeleteRelease": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRelease: func(pid interface{}, tagName string, options ...gitlab.RequestOptionFunc) (*gitlab.Release, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: release(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  release(withProjectID(projectID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, linkClient: tc.linkClient, tagClient: tc.tagClient}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryfiles
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotRepositoryFile = "managed resource is not a Gitlab repository file custom resource"
+	errGetFailed         = "cannot get Gitlab repository file"
+	errCreateFailed      = "cannot create Gitlab repository file"
+	errUpdateFailed      = "cannot update Gitlab repository file"
+	errDeleteFailed      = "cannot delete Gitlab repository file"
+	errContentFailed     = "cannot read Gitlab repository file content"
+	errContentMissing    = "exactly one of content and contentFrom must be set"
+	errDecodeContent     = "cannot decode base64 content"
+	errProjectIDMissing  = "ProjectID is missing"
+	errLintFailed        = "cannot lint Gitlab CI configuration"
+	errInvalidCIConfig   = "refusing to commit invalid Gitlab CI configuration: %s"
+)
+
+// SetupRepositoryFile adds a controller that reconciles RepositoryFiles.
+func SetupRepositoryFile(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RepositoryFileKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RepositoryFile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryFileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewRepositoryFileClient, newCILintClientFn: projects.NewCILintClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.RepositoryFileClient
+	newCILintClientFn func(cfg clients.Config) projects.CILintClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return nil, errors.New(errNotRepositoryFile)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), lintClient: c.newCILintClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube       client.Client
+	client     projects.RepositoryFileClient
+	lintClient projects.CILintClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryFile)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	f, res, err := e.client.GetFile(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.FilePath,
+		&gitlab.GetFileOptions{Ref: &cr.Spec.ForProvider.Branch},
+		gitlab.WithContext(ctx),
+	)
+	exists := true
+	if err != nil {
+		if !clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		}
+		exists = false
+	}
+
+	content, err := e.content(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := false
+	if exists {
+		cr.Status.AtProvider = projects.GenerateRepositoryFileObservation(f)
+		cr.Status.SetConditions(xpv1.Available())
+		upToDate = projects.IsRepositoryFileUpToDate(&cr.Spec.ForProvider, content, f)
+	}
+
+	// Content is linted before it is committed by Create or Update. The
+	// status set on the create path is not persisted, so content that has
+	// not recorded an outcome yet is linted too.
+	if !meta.WasDeleted(cr) && (!upToDate || cr.Status.GetCondition(v1alpha1.TypeValid).Status == corev1.ConditionUnknown) {
+		if err := e.lint(ctx, cr, content); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   exists,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryFile)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	content, err := e.content(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, _, err = e.client.CreateFile(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.FilePath,
+		projects.GenerateCreateRepositoryFileOptions(&cr.Spec.ForProvider, content),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepositoryFile)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	content, err := e.content(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The last commit observed guards against overwriting a change pushed
+	// since the file was last read.
+	_, _, err = e.client.UpdateFile(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.FilePath,
+		projects.GenerateUpdateRepositoryFileOptions(&cr.Spec.ForProvider, content, cr.Status.AtProvider.LastCommitID),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RepositoryFile)
+	if !ok {
+		return errors.New(errNotRepositoryFile)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteFile(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.FilePath,
+		projects.GenerateDeleteRepositoryFileOptions(&cr.Spec.ForProvider, cr.Status.AtProvider.LastCommitID),
+		gitlab.WithContext(ctx),
+	)
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// lint validates content through the CI lint API of the project when the
+// file holds CI configuration, and records the outcome in the Valid
+// condition. Invalid content is reported as an error so that the reconciler
+// neither creates nor updates the file.
+func (e *external) lint(ctx context.Context, cr *v1alpha1.RepositoryFile, content []byte) error {
+	if l := cr.Spec.ForProvider.LintCIConfig; l == nil || !*l {
+		return nil
+	}
+
+	res, _, err := e.lintClient.ProjectNamespaceLint(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateLintOptions(content),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return errors.Wrap(err, errLintFailed)
+	}
+
+	msg := projects.LintMessage(res)
+	if !res.Valid {
+		cr.Status.SetConditions(v1alpha1.Invalid(msg))
+		return errors.Errorf(errInvalidCIConfig, msg)
+	}
+	cr.Status.SetConditions(v1alpha1.Valid(msg))
+	return nil
+}
+
+// content returns the decoded content the file should hold.
+func (e *external) content(ctx context.Context, p *v1alpha1.RepositoryFileParameters) ([]byte, error) {
+	switch {
+	case p.Content != nil && p.ContentFrom == nil:
+		if p.Encoding != nil && *p.Encoding == projects.EncodingBase64 {
+			b, err := base64.StdEncoding.DecodeString(*p.Content)
+			return b, errors.Wrap(err, errDecodeContent)
+		}
+		return []byte(*p.Content), nil
+	case p.ContentFrom != nil && p.Content == nil:
+		obj, key, err := projects.ContentSource(p.ContentFrom)
+		if err != nil {
+			return nil, errors.Wrap(err, errContentFailed)
+		}
+		b, err := clients.GetKeyData(ctx, e.kube, obj, key)
+		return b, errors.Wrap(err, errContentFailed)
+	default:
+		return nil, errors.New(errContentMissing)
+	}
+}
@@ -0,0 +1,627 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryfiles
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	filePath     = "CODEOWNERS"
+	content      = "* @platform\n"
+	lastCommitID = "abc123"
+	invalidInput resource.Managed
+	contentFrom  = &v1alpha1.ContentSource{
+		ConfigMapKeyRef: &common.ConfigMapKeySelector{Name: "codeowners", Namespace: "default", Key: "CODEOWNERS"},
+	}
+)
+
+type args struct {
+	kube       client.Client
+	client     projects.RepositoryFileClient
+	lintClient projects.CILintClient
+	cr         resource.Managed
+}
+
+type repositoryFileModifier func(*v1alpha1.RepositoryFile)
+
+func withConditions(c ...xpv1.Condition) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withProjectID(id string) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withContent(c string) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.Content = &c }
+}
+
+func withContentFrom(s *v1alpha1.ContentSource) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.ContentFrom = s }
+}
+
+func withEncoding(e string) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.Encoding = &e }
+}
+
+func withLintCIConfig() repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Spec.ForProvider.LintCIConfig = gitlab.Bool(true) }
+}
+
+func withDeletionTimestamp() repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) {
+		t := metav1.Unix(1, 0)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withStatus(s v1alpha1.RepositoryFileObservation) repositoryFileModifier {
+	return func(r *v1alpha1.RepositoryFile) { r.Status.AtProvider = s }
+}
+
+func repositoryFile(m ...repositoryFileModifier) *v1alpha1.RepositoryFile {
+	cr := &v1alpha1.RepositoryFile{}
+	cr.Spec.ForProvider.Branch = "main"
+	cr.Spec.ForProvider.FilePath = filePath
+	cr.Spec.ForProvider.CommitMessage = "Manage CODEOWNERS"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getFile(blobID string) func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+		return &gitlab.File{BlobID: blobID, LastCommitID: lastCommitID}, &gitlab.Response{}, nil
+	}
+}
+
+func lint(valid bool) func(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error) {
+	return func(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error) {
+		if valid {
+			return &gitlab.ProjectLintResult{Valid: true}, &gitlab.Response{}, nil
+		}
+		return &gitlab.ProjectLintResult{Errors: []string{"jobs config should contain at least one visible job"}}, &gitlab.Response{}, nil
+	}
+}
+
+func fileNotFound(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+	return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+}
+
+func lintFails(pid interface{}, opt *gitlab.ProjectNamespaceLintOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectLintResult, *gitlab.Response, error) {
+	return nil, &gitlab.Response{}, errBoom
+}
+
+func TestObserve(t *testing.T) {
+	configMapWithContent := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok {
+			return errors.Wrapf(errBoom, "unexpected object type %T, expected %T", obj, cm)
+		}
+		cm.Data = map[string]string{"CODEOWNERS": content}
+		return nil
+	}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRepositoryFile),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: repositoryFile(),
+			},
+			want: want{
+				cr:  repositoryFile(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile: func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+			want: want{
+				cr: repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+		},
+		"NotFoundValidCIConfig": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: fileNotFound},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(true)},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig()),
+			},
+			want: want{
+				cr: repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig(), withConditions(v1alpha1.Valid(""))),
+			},
+		},
+		"NotFoundInvalidCIConfig": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: fileNotFound},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(false)},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig()),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig(), withConditions(v1alpha1.Invalid("error: jobs config should contain at least one visible job"))),
+				err: errors.Errorf(errInvalidCIConfig, "error: jobs config should contain at least one visible job"),
+			},
+		},
+		"NotFoundFailedLint": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: fileNotFound},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lintFails},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig()),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig()),
+				err: errors.Wrap(errBoom, errLintFailed),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetFile: func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"MissingContent": {
+			args: args{
+				client: &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				cr:     repositoryFile(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID)),
+				err: errors.New(errContentMissing),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				cr:     repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent(content),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte(content)), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDateNotLinted": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(true)},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig()),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent(content),
+					withLintCIConfig(),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte(content)), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available(), v1alpha1.Valid("")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDateLinted": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lintFails},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig(), withConditions(v1alpha1.Valid(""))),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent(content),
+					withLintCIConfig(),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte(content)), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available(), v1alpha1.Valid("")),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDateBase64": {
+			args: args{
+				client: &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				cr:     repositoryFile(withProjectID(projectID), withContent("KiBAcGxhdGZvcm0K"), withEncoding("base64")),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent("KiBAcGxhdGZvcm0K"),
+					withEncoding("base64"),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte(content)), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				kube:   &test.MockClient{MockGet: configMapWithContent},
+				client: &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte("old")))},
+				cr:     repositoryFile(withProjectID(projectID), withContentFrom(contentFrom)),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContentFrom(contentFrom),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte("old")), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ContentChangedInvalidCIConfig": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte("old")))},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(false)},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig(), withConditions(v1alpha1.Valid(""))),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent(content),
+					withLintCIConfig(),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte("old")), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available(), v1alpha1.Invalid("error: jobs config should contain at least one visible job")),
+				),
+				err: errors.Errorf(errInvalidCIConfig, "error: jobs config should contain at least one visible job"),
+			},
+		},
+		"ContentChangedDeleted": {
+			args: args{
+				client:     &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte("old")))},
+				lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(false)},
+				cr:         repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: repositoryFile(
+					withProjectID(projectID),
+					withContent(content),
+					withLintCIConfig(),
+					withDeletionTimestamp(),
+					withStatus(v1alpha1.RepositoryFileObservation{BlobID: projects.BlobSHA([]byte("old")), LastCommitID: lastCommitID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"FailedContentRead": {
+			args: args{
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: &fake.MockClient{MockGetFile: getFile(projects.BlobSHA([]byte(content)))},
+				cr:     repositoryFile(withProjectID(projectID), withContentFrom(contentFrom)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withContentFrom(contentFrom)),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get default/codeowners"), errContentFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, lintClient: tc.lintClient}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRepositoryFile),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateFile: func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+						if *opt.Content != content {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.FileInfo{FilePath: fileName, Branch: *opt.Branch}, &gitlab.Response{}, nil
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+			want: want{
+				cr:     repositoryFile(withProjectID(projectID), withContent(content), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateFile: func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withContent(content), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, lintClient: tc.lintClient}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestCreateFlow walks through the calls the managed reconciler makes to
+// create a file, to make sure the Valid condition is recorded even though the
+// reconciler discards the status set before the file is created.
+func TestCreateFlow(t *testing.T) {
+	created := false
+	e := &external{
+		client: &fake.MockClient{
+			MockGetFile: func(pid interface{}, fileName string, opt *gitlab.GetFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.File, *gitlab.Response, error) {
+				if !created {
+					return fileNotFound(pid, fileName, opt, options...)
+				}
+				return getFile(projects.BlobSHA([]byte(content)))(pid, fileName, opt, options...)
+			},
+			MockCreateFile: func(pid interface{}, fileName string, opt *gitlab.CreateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+				created = true
+				return &gitlab.FileInfo{}, &gitlab.Response{}, nil
+			},
+		},
+		lintClient: &fake.MockClient{MockProjectNamespaceLint: lint(true)},
+	}
+	cr := repositoryFile(withProjectID(projectID), withContent(content), withLintCIConfig())
+
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil || o.ResourceExists {
+		t.Fatalf("Observe(...): want missing file, got %+v, %v", o, err)
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+
+	// The reconciler reads the resource back after creating it, which resets
+	// the status to the last one persisted.
+	cr.Status = v1alpha1.RepositoryFileStatus{}
+
+	o, err = e.Observe(context.Background(), cr)
+	if err != nil || !o.ResourceExists || !o.ResourceUpToDate {
+		t.Fatalf("Observe(...): want up to date file, got %+v, %v", o, err)
+	}
+	if diff := cmp.Diff(v1alpha1.Valid(""), cr.Status.GetCondition(v1alpha1.TypeValid), test.EquateConditions()); diff != "" {
+		t.Errorf("Valid condition: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRepositoryFile),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateFile: func(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+						if *opt.LastCommitID != lastCommitID {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.FileInfo{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID), withContent(content), withStatus(v1alpha1.RepositoryFileObservation{LastCommitID: lastCommitID})),
+			},
+			want: want{
+				cr: repositoryFile(withProjectID(projectID), withContent(content), withStatus(v1alpha1.RepositoryFileObservation{LastCommitID: lastCommitID})),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateFile: func(pid interface{}, fileName string, opt *gitlab.UpdateFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.FileInfo, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID), withContent(content)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withContent(content)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, lintClient: tc.lintClient}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRepositoryFile),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteFile: func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID)),
+			},
+			want: want{
+				cr: repositoryFile(withProjectID(projectID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteFile: func(pid interface{}, fileName string, opt *gitlab.DeleteFileOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: repositoryFile(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  repositoryFile(withProjectID(projectID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client, lintClient: tc.lintClient}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runners
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+)
+
+const (
+	errNotRunner        = "managed resource is not a Gitlab runner custom resource"
+	errIDnotInt         = "ID is not an integer"
+	errGetFailed        = "cannot get Gitlab runner"
+	errCreateFailed     = "cannot create Gitlab runner"
+	errUpdateFailed     = "cannot update Gitlab runner"
+	errDeleteFailed     = "cannot delete Gitlab runner"
+	errProjectIDMissing = "ProjectID is missing"
+	errGroupIDMissing   = "GroupID is missing"
+	errGetSecretFailed  = "cannot get connection secret of Gitlab runner"
+	errResetFailed      = "cannot reset authentication token of Gitlab runner"
+)
+
+// SetupRunner adds a controller that reconciles Runners.
+func SetupRunner(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RunnerKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Runner{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RunnerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewRunnerClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.RunnerClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Runner)
+	if !ok {
+		return nil, errors.New(errNotRunner)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.RunnerClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Runner)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRunner)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	id, err := strconv.Atoi(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDnotInt)
+	}
+
+	r, res, err := e.client.GetRunnerDetails(id, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateRunnerObservation(r)
+	cr.Status.SetConditions(xpv1.Available())
+
+	missing, err := e.tokenMissing(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projects.IsRunnerUpToDate(&cr.Spec.ForProvider, r) && !missing,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Runner)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRunner)
+	}
+
+	switch cr.Spec.ForProvider.RunnerType {
+	case v1alpha1.ProjectTypeRunner:
+		if cr.Spec.ForProvider.ProjectID == nil {
+			return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+		}
+	case v1alpha1.GroupTypeRunner:
+		if cr.Spec.ForProvider.GroupID == nil {
+			return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+		}
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	r, _, err := e.client.CreateUserRunner(projects.GenerateCreateUserRunnerOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(r.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connectionDetails(r.Token)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Runner)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRunner)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDnotInt)
+	}
+
+	if _, _, err := e.client.UpdateRunnerDetails(id, projects.GenerateUpdateRunnerDetailsOptions(&cr.Spec.ForProvider), gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	missing, err := e.tokenMissing(ctx, cr)
+	if err != nil || !missing {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// GitLab only returns the token on creation, a new one has to be issued
+	// when it was lost.
+	t, _, err := e.client.ResetRunnerAuthenticationToken(id, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errResetFailed)
+	}
+	if t.Token == nil {
+		return managed.ExternalUpdate{}, errors.New(errResetFailed)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: connectionDetails(*t.Token)}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Runner)
+	if !ok {
+		return errors.New(errNotRunner)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDnotInt)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err = e.client.RemoveRunner(id, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// tokenMissing reports whether cr has a connection secret that lacks the
+// authentication token of the runner.
+func (e *external) tokenMissing(ctx context.Context, cr *v1alpha1.Runner) (bool, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return false, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrap(err, errGetSecretFailed)
+	}
+	return len(s.Data["token"]) == 0, nil
+}
+
+// connectionDetails returns the connection details for the authentication
+// token of a runner. The gitlab-runner Helm chart reads it from runner-token
+// and still expects runner-registration-token to be present.
+func connectionDetails(token string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		"token":                     []byte(token),
+		"runner-token":              []byte(token),
+		"runner-registration-token": []byte(""),
+	}
+}
@@ -0,0 +1,560 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runners
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = 1234
+	groupID      = 5678
+	runnerID     = 42
+	description  = "Kubernetes runner"
+	token        = "glrt-0123456789abcdef"
+	invalidInput resource.Managed
+)
+
+type args struct {
+	kube   client.Client
+	client projects.RunnerClient
+	cr     resource.Managed
+}
+
+type runnerModifier func(*v1alpha1.Runner)
+
+func withConditions(c ...xpv1.Condition) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRunnerType(t string) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Spec.ForProvider.RunnerType = t }
+}
+
+func withProjectID(id int) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Spec.ForProvider.ProjectID = &id }
+}
+
+func withGroupID(id int) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Spec.ForProvider.GroupID = &id }
+}
+
+func withTagList(t ...string) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Spec.ForProvider.TagList = t }
+}
+
+func withExternalName(n string) runnerModifier {
+	return func(r *v1alpha1.Runner) { meta.SetExternalName(r, n) }
+}
+
+func withConnectionSecret() runnerModifier {
+	return func(r *v1alpha1.Runner) {
+		r.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "runner", Namespace: "default"})
+	}
+}
+
+func connectionSecret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func withStatus(s v1alpha1.RunnerObservation) runnerModifier {
+	return func(r *v1alpha1.Runner) { r.Status.AtProvider = s }
+}
+
+func runner(m ...runnerModifier) *v1alpha1.Runner {
+	cr := &v1alpha1.Runner{}
+	cr.Spec.ForProvider.RunnerType = v1alpha1.ProjectTypeRunner
+	cr.Spec.ForProvider.Description = &description
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRunner),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: runner(withProjectID(projectID)),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID)),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: runner(withProjectID(projectID), withExternalName("fr")),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("fr")),
+				err: errors.New(errIDnotInt),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{ID: runnerID, Description: description, Status: "online", Online: true, TagList: []string{"linux", "docker"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withTagList("docker", "linux"), withExternalName("42")),
+			},
+			want: want{
+				cr: runner(
+					withProjectID(projectID),
+					withTagList("docker", "linux"),
+					withExternalName("42"),
+					withStatus(v1alpha1.RunnerObservation{Status: "online", Online: true}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{ID: runnerID, Description: description, TagList: []string{"linux"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withTagList("docker", "linux"), withExternalName("42")),
+			},
+			want: want{
+				cr: runner(
+					withProjectID(projectID),
+					withTagList("docker", "linux"),
+					withExternalName("42"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"TokenPublished": {
+			args: args{
+				kube: &test.MockClient{MockGet: connectionSecret(map[string][]byte{"token": []byte(token)})},
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{ID: runnerID, Description: description}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr:     runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ConnectionSecretMissing": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "runner"))},
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{ID: runnerID, Description: description}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr:     runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"FailedGetConnectionSecret": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: &fake.MockClient{
+					MockGetRunnerDetails: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{ID: runnerID, Description: description}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret(), withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errGetSecretFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRunner),
+			},
+		},
+		"MissingProjectID": {
+			args: args{
+				cr: runner(),
+			},
+			want: want{
+				cr:  runner(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"MissingGroupID": {
+			args: args{
+				cr: runner(withRunnerType(v1alpha1.GroupTypeRunner)),
+			},
+			want: want{
+				cr:  runner(withRunnerType(v1alpha1.GroupTypeRunner)),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUserRunner: func(opt *projects.CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*projects.UserRunner, *gitlab.Response, error) {
+						if *opt.RunnerType != v1alpha1.ProjectTypeRunner || *opt.ProjectID != projectID || opt.GroupID != nil {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &projects.UserRunner{ID: runnerID, Token: token}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withGroupID(groupID)),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withGroupID(groupID), withExternalName("42"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"token":                     []byte(token),
+						"runner-token":              []byte(token),
+						"runner-registration-token": []byte(""),
+					},
+				},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUserRunner: func(opt *projects.CreateUserRunnerOptions, options ...gitlab.RequestOptionFunc) (*projects.UserRunner, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID)),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRunner),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateRunnerDetails: func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						if rid != runnerID || *opt.Description != description {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.RunnerDetails{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateRunnerDetails: func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("42")),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"ResetMissingToken": {
+			args: args{
+				kube: &test.MockClient{MockGet: connectionSecret(nil)},
+				client: &fake.MockClient{
+					MockUpdateRunnerDetails: func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{}, &gitlab.Response{}, nil
+					},
+					MockResetRunnerAuthenticationToken: func(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error) {
+						if rid != runnerID {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.RunnerAuthenticationToken{Token: &token}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+				result: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					"token":                     []byte(token),
+					"runner-token":              []byte(token),
+					"runner-registration-token": []byte(""),
+				}},
+			},
+		},
+		"TokenPublishedSkipsReset": {
+			args: args{
+				kube: &test.MockClient{MockGet: connectionSecret(map[string][]byte{"token": []byte(token)})},
+				client: &fake.MockClient{
+					MockUpdateRunnerDetails: func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+		},
+		"FailedReset": {
+			args: args{
+				kube: &test.MockClient{MockGet: connectionSecret(nil)},
+				client: &fake.MockClient{
+					MockUpdateRunnerDetails: func(rid interface{}, opt *gitlab.UpdateRunnerDetailsOptions, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerDetails, *gitlab.Response, error) {
+						return &gitlab.RunnerDetails{}, &gitlab.Response{}, nil
+					},
+					MockResetRunnerAuthenticationToken: func(rid int, options ...gitlab.RequestOptionFunc) (*gitlab.RunnerAuthenticationToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("42"), withConnectionSecret()),
+				err: errors.Wrap(errBoom, errResetFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotRunner),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockRemoveRunner: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr: runner(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockRemoveRunner: func(rid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: runner(withProjectID(projectID), withExternalName("42")),
+			},
+			want: want{
+				cr:  runner(withProjectID(projectID), withExternalName("42"), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
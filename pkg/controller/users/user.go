@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package users
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+)
+
+const (
+	errNotUser      = "managed resource is not a Gitlab User custom resource"
+	errIDNotInt     = "specified ID is not an integer"
+	errGetFailed    = "cannot get Gitlab User"
+	errCreateFailed = "cannot create Gitlab User"
+	errUpdateFailed = "cannot update Gitlab User"
+	errDeleteFailed = "cannot delete Gitlab User"
+)
+
+// SetupUser adds a controller that reconciles Users.
+func SetupUser(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.User{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UserGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: users.NewClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) users.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client users.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	userID, err := strconv.Atoi(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+
+	usr, res, err := e.client.GetUser(userID, gitlab.GetUsersOptions{})
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, usr)
+
+	cr.Status.AtProvider = users.GenerateObservation(usr)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUserUpToDate(cr.Name, &cr.Spec.ForProvider, usr),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	usr, _, err := e.client.CreateUser(
+		users.GenerateCreateUserOptions(cr.Name, &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(usr.ID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+
+	userID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	_, _, err = e.client.ModifyUser(
+		userID,
+		users.GenerateModifyUserOptions(cr.Name, &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+
+	userID, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotInt)
+	}
+
+	_, err = e.client.DeleteUser(userID, gitlab.WithContext(ctx))
+	return errors.Wrap(err, errDeleteFailed)
+}
+
+// lateInitialize fills the empty fields in the user spec with the
+// values seen in gitlab.User.
+func lateInitialize(in *v1alpha1.UserParameters, usr *gitlab.User) { // nolint:gocyclo
+	if usr == nil {
+		return
+	}
+	if in.Admin == nil {
+		in.Admin = &usr.IsAdmin
+	}
+	if in.External == nil {
+		in.External = &usr.External
+	}
+	if in.CanCreateGroup == nil {
+		in.CanCreateGroup = &usr.CanCreateGroup
+	}
+	if in.PrivateProfile == nil {
+		in.PrivateProfile = &usr.PrivateProfile
+	}
+	if in.ProjectsLimit == nil {
+		in.ProjectsLimit = &usr.ProjectsLimit
+	}
+
+	in.Bio = clients.LateInitializeStringPtr(in.Bio, usr.Bio)
+	in.Location = clients.LateInitializeStringPtr(in.Location, usr.Location)
+	in.Organization = clients.LateInitializeStringPtr(in.Organization, usr.Organization)
+	in.JobTitle = clients.LateInitializeStringPtr(in.JobTitle, usr.JobTitle)
+	in.WebsiteURL = clients.LateInitializeStringPtr(in.WebsiteURL, usr.WebsiteURL)
+	in.Note = clients.LateInitializeStringPtr(in.Note, usr.Note)
+}
+
+// isUserUpToDate checks whether there is a change in any of the modifiable fields.
+func isUserUpToDate(name string, p *v1alpha1.UserParameters, g *gitlab.User) bool { // nolint:gocyclo
+	if p.Name != nil {
+		name = *p.Name
+	}
+	if !cmp.Equal(name, g.Name) {
+		return false
+	}
+	if !cmp.Equal(p.Username, g.Username) {
+		return false
+	}
+	if !cmp.Equal(p.Email, g.Email) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.Admin, g.IsAdmin) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.External, g.External) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.CanCreateGroup, g.CanCreateGroup) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.PrivateProfile, g.PrivateProfile) {
+		return false
+	}
+	if !clients.IsIntEqualToIntPtr(p.ProjectsLimit, g.ProjectsLimit) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Bio, g.Bio) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Location, g.Location) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Organization, g.Organization) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.JobTitle, g.JobTitle) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.WebsiteURL, g.WebsiteURL) {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Note, g.Note) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,555 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package users
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/users/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users/fake"
+)
+
+var (
+	unexpecedItem resource.Managed
+	errBoom       = errors.New("boom")
+	userID        = 1234
+	extName       = "1234"
+	name          = "example-user"
+	username      = "example-bot"
+	email         = "bot@example.com"
+)
+
+type args struct {
+	user users.Client
+	kube client.Client
+	cr   resource.Managed
+}
+
+type userModifier func(*v1alpha1.User)
+
+func withConditions(c ...xpv1.Condition) userModifier {
+	return func(cr *v1alpha1.User) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) userModifier {
+	return func(r *v1alpha1.User) { meta.SetExternalName(r, n) }
+}
+
+func withName(n string) userModifier {
+	return func(r *v1alpha1.User) { r.Name = n }
+}
+
+func withSpec(p v1alpha1.UserParameters) userModifier {
+	return func(r *v1alpha1.User) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.UserObservation) userModifier {
+	return func(r *v1alpha1.User) { r.Status.AtProvider = s }
+}
+
+// Use for testing. When ResourceLateInitialized should it be false
+func withClientDefaultValues() userModifier {
+	return func(u *v1alpha1.User) {
+		f := false
+		i := 0
+		u.Spec.ForProvider.Admin = &f
+		u.Spec.ForProvider.External = &f
+		u.Spec.ForProvider.CanCreateGroup = &f
+		u.Spec.ForProvider.PrivateProfile = &f
+		u.Spec.ForProvider.ProjectsLimit = &i
+	}
+}
+
+func user(m ...userModifier) *v1alpha1.User {
+	cr := &v1alpha1.User{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalClient
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotUser),
+			},
+		},
+		"ProviderConfigRefNotGivenError": {
+			args: args{
+				cr:   user(),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+			want: want{
+				cr:  user(),
+				err: errors.New("providerConfigRef is not given"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{kube: tc.kube, newGitlabClientFn: nil}
+			o, err := c.Connect(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	state := "active"
+	empty := ""
+	f := false
+	i := 0
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotUser),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: user(),
+			},
+			want: want{
+				cr: user(),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: user(withExternalName("fr")),
+			},
+			want: want{
+				cr:  user(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr:  user(withExternalName(extName)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ErrGet404": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr:     user(withExternalName(extName)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SuccessfulAvailable": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{ID: userID, Name: name, Username: username, Email: email, State: state}, &gitlab.Response{}, nil
+					},
+				},
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withClientDefaultValues(),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserObservation{
+						ID:          &userID,
+						State:       &state,
+						WebURL:      &empty,
+						AvatarURL:   &empty,
+						Bot:         &f,
+						NamespaceID: &i,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{ID: userID, Name: name, Username: username, Email: email, State: state}, &gitlab.Response{}, nil
+					},
+				},
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserObservation{
+						ID:          &userID,
+						State:       &state,
+						WebURL:      &empty,
+						AvatarURL:   &empty,
+						Bot:         &f,
+						NamespaceID: &i,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				user: &fake.MockClient{
+					MockGetUser: func(user int, opt gitlab.GetUsersOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{ID: userID, Name: name, Username: username, Email: "old@example.com", State: state}, &gitlab.Response{}, nil
+					},
+				},
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withClientDefaultValues(),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: user(
+					withName(name),
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.UserObservation{
+						ID:          &userID,
+						State:       &state,
+						WebURL:      &empty,
+						AvatarURL:   &empty,
+						Bot:         &f,
+						NamespaceID: &i,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.user}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotUser),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				user: &fake.MockClient{
+					MockCreateUser: func(opt *gitlab.CreateUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{ID: userID, Username: *opt.Username}, &gitlab.Response{}, nil
+					},
+				},
+				cr: user(withSpec(v1alpha1.UserParameters{Username: username, Email: email})),
+			},
+			want: want{
+				cr: user(
+					withSpec(v1alpha1.UserParameters{Username: username, Email: email}),
+					withExternalName(extName),
+				),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				user: &fake.MockClient{
+					MockCreateUser: func(opt *gitlab.CreateUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: user(withSpec(v1alpha1.UserParameters{Username: username, Email: email})),
+			},
+			want: want{
+				cr:  user(withSpec(v1alpha1.UserParameters{Username: username, Email: email})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.user}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotUser),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: user(withExternalName("fr")),
+			},
+			want: want{
+				cr:  user(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				user: &fake.MockClient{
+					MockModifyUser: func(user int, opt *gitlab.ModifyUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return &gitlab.User{ID: user}, &gitlab.Response{}, nil
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr: user(withExternalName(extName)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				user: &fake.MockClient{
+					MockModifyUser: func(user int, opt *gitlab.ModifyUserOptions, options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr:  user(withExternalName(extName)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.user}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotUser),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				user: &fake.MockClient{
+					MockDeleteUser: func(user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr: user(withExternalName(extName)),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				user: &fake.MockClient{
+					MockDeleteUser: func(user int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: user(withExternalName(extName)),
+			},
+			want: want{
+				cr:  user(withExternalName(extName)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.user}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}